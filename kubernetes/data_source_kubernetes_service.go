@@ -35,6 +35,16 @@ func dataSourceKubernetesService() *schema.Resource {
 							Description: "The external reference that kubedns or equivalent will return as a CNAME record for this service. No proxying will be involved. Must be a valid DNS name and requires `type` to be `ExternalName`.",
 							Computed:    true,
 						},
+						"external_traffic_policy": {
+							Type:        schema.TypeString,
+							Description: "Denotes if this service desires to route external traffic to node-local or cluster-wide endpoints. `Local` preserves the client source IP and avoids a second hop for `LoadBalancer` and `NodePort` type services, but risks potentially imbalanced traffic spreading. `Cluster` obscures the client source IP and may cause a second hop to another node, but should have good overall load-spreading. More info: https://kubernetes.io/docs/tutorials/services/source-ip/",
+							Computed:    true,
+						},
+						"health_check_node_port": {
+							Type:        schema.TypeInt,
+							Description: "The node port used by the cloud load balancer to health check the service. Only applies to `type = LoadBalancer` with `external_traffic_policy = \"Local\"`.",
+							Computed:    true,
+						},
 						"load_balancer_ip": {
 							Type:        schema.TypeString,
 							Description: "Only applies to `type = LoadBalancer`. LoadBalancer will get created with the IP specified in this field. This feature depends on whether the underlying cloud-provider supports specifying this field when a load balancer is created. This field will be ignored if the cloud-provider does not support the feature.",
@@ -75,13 +85,18 @@ func dataSourceKubernetesService() *schema.Resource {
 										Computed:    true,
 									},
 									"target_port": {
-										Type:        schema.TypeInt,
-										Description: "Number or name of the port to access on the pods targeted by the service. Number must be in the range 1 to 65535. This field is ignored for services with `cluster_ip = \"None\"`. More info: http://kubernetes.io/docs/user-guide/services#defining-a-service",
+										Type:        schema.TypeString,
+										Description: "Number or name of the port to access on the pods targeted by the service. Number must be in the range 1 to 65535. Name must match a named port in the pods' containers. This field is ignored for services with `cluster_ip = \"None\"`. More info: http://kubernetes.io/docs/user-guide/services#defining-a-service",
 										Computed:    true,
 									},
 								},
 							},
 						},
+						"publish_not_ready_addresses": {
+							Type:        schema.TypeBool,
+							Description: "Whether endpoints are published for pods that are not ready.",
+							Computed:    true,
+						},
 						"selector": {
 							Type:        schema.TypeMap,
 							Description: "Route service traffic to pods with label keys and values matching this selector. Only applies to types `ClusterIP`, `NodePort`, and `LoadBalancer`. More info: http://kubernetes.io/docs/user-guide/services#overview",
@@ -125,6 +140,9 @@ func dataSourceKubernetesServiceRead(d *schema.ResourceData, meta interface{}) e
 		Namespace: d.Get("metadata.0.namespace").(string),
 		Name:      d.Get("metadata.0.name").(string),
 	}
+	if om.Namespace == "" {
+		om.Namespace = meta.(*kubeProvider).namespace
+	}
 	d.SetId(buildId(om))
 
 	return resourceKubernetesServiceRead(d, meta)
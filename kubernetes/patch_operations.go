@@ -1,10 +1,14 @@
 package kubernetes
 
 import (
+	"bytes"
 	"encoding/json"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func diffStringMap(pathPrefix string, oldV, newV map[string]interface{}) PatchOperations {
@@ -50,6 +54,329 @@ func diffStringMap(pathPrefix string, oldV, newV map[string]interface{}) PatchOp
 	return ops
 }
 
+// diffListItems returns the operations turning the list at path from
+// oldV into newV, where the order of the items doesn't matter, such as
+// finalizers. Items are removed from the back first, so that indexes of
+// the remaining ones stay valid, and new ones are appended.
+func diffListItems(path string, oldV, newV []interface{}) PatchOperations {
+	ops := make([]PatchOperation, 0, 0)
+
+	path = strings.TrimRight(path, "/")
+
+	if len(oldV) == 0 {
+		// The list may be absent, so it can't be appended to
+		if len(newV) > 0 {
+			ops = append(ops, &AddOperation{
+				Path:  path,
+				Value: newV,
+			})
+		}
+		return ops
+	}
+
+	for i := len(oldV) - 1; i >= 0; i-- {
+		if !containsListItem(newV, oldV[i]) {
+			ops = append(ops, &RemoveOperation{
+				Path: path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	for _, v := range newV {
+		if !containsListItem(oldV, v) {
+			ops = append(ops, &AddOperation{
+				Path:  path + "/-",
+				Value: v,
+			})
+		}
+	}
+	return ops
+}
+
+// diffManagedListItems returns the operations turning the items of live
+// which are managed by Terraform, oldV, into newV. Other items of live,
+// such as those added by controllers, are left alone. Items are matched
+// by key, so ones whose value changed are replaced in place. Removals
+// go from the back, so that indexes of the remaining items stay valid.
+func diffManagedListItems(path string, live, oldV, newV []interface{}, key func(interface{}) interface{}) PatchOperations {
+	ops := make([]PatchOperation, 0, 0)
+
+	path = strings.TrimRight(path, "/")
+
+	if len(live) == 0 {
+		// The list may be absent, so it can't be appended to
+		if len(newV) > 0 {
+			ops = append(ops, &AddOperation{
+				Path:  path,
+				Value: newV,
+			})
+		}
+		return ops
+	}
+
+	indexOf := func(l []interface{}, item interface{}) int {
+		for i, v := range l {
+			if key(v) == key(item) {
+				return i
+			}
+		}
+		return -1
+	}
+	for _, v := range newV {
+		if i := indexOf(live, v); i >= 0 && !reflect.DeepEqual(live[i], v) {
+			ops = append(ops, &ReplaceOperation{
+				Path:  path + "/" + strconv.Itoa(i),
+				Value: v,
+			})
+		}
+	}
+	for i := len(live) - 1; i >= 0; i-- {
+		if indexOf(oldV, live[i]) >= 0 && indexOf(newV, live[i]) < 0 {
+			ops = append(ops, &RemoveOperation{
+				Path: path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	for _, v := range newV {
+		if indexOf(live, v) < 0 {
+			ops = append(ops, &AddOperation{
+				Path:  path + "/-",
+				Value: v,
+			})
+		}
+	}
+	return ops
+}
+
+func containsListItem(l []interface{}, item interface{}) bool {
+	for _, v := range l {
+		if reflect.DeepEqual(v, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// diffObjects returns the operations needed to turn oldV into newV,
+// placed under pathPrefix. Both values can be anything that encodes to
+// JSON, typically expanded API objects or nested []interface{} state,
+// and are compared in their JSON form.
+//
+// Only fields which differ are touched, so fields set outside of
+// Terraform (e.g. defaulted by the API server) are left alone as long
+// as they are absent from both values.
+func diffObjects(pathPrefix string, oldV, newV interface{}) (PatchOperations, error) {
+	o, err := toJSONValue(oldV)
+	if err != nil {
+		return nil, err
+	}
+	n, err := toJSONValue(newV)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := make([]PatchOperation, 0)
+	return diffJSONValues(ops, strings.TrimRight(pathPrefix, "/"), o, n), nil
+}
+
+// toJSONValue converts v into the generic form produced by decoding
+// JSON, keeping numbers intact as json.Number.
+func toJSONValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(setsToLists(v))
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var out interface{}
+	err = dec.Decode(&out)
+	return out, err
+}
+
+// setsToLists replaces *schema.Set found in state with plain lists,
+// since sets don't encode to JSON.
+func setsToLists(v interface{}) interface{} {
+	switch t := v.(type) {
+	case *schema.Set:
+		return setsToLists(t.List())
+	case []interface{}:
+		out := make([]interface{}, len(t), len(t))
+		for i, e := range t {
+			out[i] = setsToLists(e)
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			out[k] = setsToLists(e)
+		}
+		return out
+	}
+	return v
+}
+
+func diffJSONValues(ops []PatchOperation, path string, oldV, newV interface{}) []PatchOperation {
+	switch o := oldV.(type) {
+	case map[string]interface{}:
+		if n, ok := newV.(map[string]interface{}); ok {
+			return diffJSONMaps(ops, path, o, n)
+		}
+	case []interface{}:
+		if n, ok := newV.([]interface{}); ok {
+			return diffJSONLists(ops, path, o, n)
+		}
+	}
+	if reflect.DeepEqual(oldV, newV) {
+		return ops
+	}
+	return append(ops, &ReplaceOperation{
+		Path:  path,
+		Value: newV,
+	})
+}
+
+func diffJSONMaps(ops []PatchOperation, path string, oldV, newV map[string]interface{}) []PatchOperation {
+	keys := make([]string, 0, len(oldV)+len(newV))
+	for k := range oldV {
+		keys = append(keys, k)
+	}
+	for k := range newV {
+		if _, ok := oldV[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		p := path + "/" + escapeJsonPointer(k)
+		o, inOld := oldV[k]
+		n, inNew := newV[k]
+		switch {
+		case !inNew:
+			ops = append(ops, &RemoveOperation{
+				Path: p,
+			})
+		case !inOld:
+			ops = append(ops, &AddOperation{
+				Path:  p,
+				Value: n,
+			})
+		default:
+			ops = diffJSONValues(ops, p, o, n)
+		}
+	}
+	return ops
+}
+
+func diffJSONLists(ops []PatchOperation, path string, oldV, newV []interface{}) []PatchOperation {
+	oldNames, oldKeyed := listNames(oldV)
+	newNames, newKeyed := listNames(newV)
+	if oldKeyed && newKeyed {
+		return diffNamedJSONLists(ops, path, oldV, newV, oldNames, newNames)
+	}
+
+	// Without names to match on, elements are compared by position
+	common := len(oldV)
+	if len(newV) < common {
+		common = len(newV)
+	}
+	for i := 0; i < common; i++ {
+		ops = diffJSONValues(ops, path+"/"+strconv.Itoa(i), oldV[i], newV[i])
+	}
+	for i := len(oldV) - 1; i >= common; i-- {
+		ops = append(ops, &RemoveOperation{
+			Path: path + "/" + strconv.Itoa(i),
+		})
+	}
+	for i := common; i < len(newV); i++ {
+		ops = append(ops, &AddOperation{
+			Path:  path + "/" + strconv.Itoa(i),
+			Value: newV[i],
+		})
+	}
+	return ops
+}
+
+// diffNamedJSONLists diffs lists of objects identified by their name,
+// such as containers, ports or environment variables. Elements are
+// removed from the back first, so that indexes of the remaining ones
+// stay valid, then the remaining ones are diffed and new ones are
+// inserted at their final position.
+func diffNamedJSONLists(ops []PatchOperation, path string, oldV, newV []interface{}, oldNames, newNames []string) []PatchOperation {
+	oldIndex := make(map[string]int, len(oldNames))
+	for i, name := range oldNames {
+		oldIndex[name] = i
+	}
+	newIndex := make(map[string]int, len(newNames))
+	for i, name := range newNames {
+		newIndex[name] = i
+	}
+
+	kept := make([]string, 0, len(oldNames))
+	for _, name := range oldNames {
+		if _, ok := newIndex[name]; ok {
+			kept = append(kept, name)
+		}
+	}
+	i := 0
+	for _, name := range newNames {
+		if _, ok := oldIndex[name]; !ok {
+			continue
+		}
+		if kept[i] != name {
+			// Elements were reordered, there is no cheaper way
+			// than swapping the whole list
+			return append(ops, &ReplaceOperation{
+				Path:  path,
+				Value: newV,
+			})
+		}
+		i++
+	}
+
+	for i := len(oldNames) - 1; i >= 0; i-- {
+		if _, ok := newIndex[oldNames[i]]; !ok {
+			ops = append(ops, &RemoveOperation{
+				Path: path + "/" + strconv.Itoa(i),
+			})
+		}
+	}
+	for i, name := range kept {
+		ops = diffJSONValues(ops, path+"/"+strconv.Itoa(i), oldV[oldIndex[name]], newV[newIndex[name]])
+	}
+	for i, name := range newNames {
+		if _, ok := oldIndex[name]; !ok {
+			ops = append(ops, &AddOperation{
+				Path:  path + "/" + strconv.Itoa(i),
+				Value: newV[i],
+			})
+		}
+	}
+	return ops
+}
+
+// listNames returns the names of all elements in the list, provided
+// every element is an object with a unique, non-empty name.
+func listNames(l []interface{}) ([]string, bool) {
+	if len(l) == 0 {
+		return nil, true
+	}
+	names := make([]string, len(l), len(l))
+	seen := make(map[string]bool, len(l))
+	for i, e := range l {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, ok := m["name"].(string)
+		if !ok || name == "" || seen[name] {
+			return nil, false
+		}
+		seen[name] = true
+		names[i] = name
+	}
+	return names, true
+}
+
 // escapeJsonPointer escapes string per RFC 6901
 // so it can be used as path in JSON patch operations
 func escapeJsonPointer(path string) string {
@@ -143,3 +470,23 @@ func (o *RemoveOperation) String() string {
 	b, _ := o.MarshalJSON()
 	return string(b)
 }
+
+type TestOperation struct {
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+	Op    string      `json:"op"`
+}
+
+func (o *TestOperation) GetPath() string {
+	return o.Path
+}
+
+func (o *TestOperation) MarshalJSON() ([]byte, error) {
+	o.Op = "test"
+	return json.Marshal(*o)
+}
+
+func (o *TestOperation) String() string {
+	b, _ := o.MarshalJSON()
+	return string(b)
+}
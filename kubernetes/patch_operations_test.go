@@ -1,8 +1,12 @@
 package kubernetes
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/kubernetes/pkg/api/v1"
 )
 
 func TestDiffStringMap(t *testing.T) {
@@ -148,6 +152,225 @@ func TestDiffStringMap(t *testing.T) {
 	}
 }
 
+func TestDiffObjects(t *testing.T) {
+	testCases := []struct {
+		Path        string
+		Old         interface{}
+		New         interface{}
+		ExpectedOps PatchOperations
+	}{
+		{
+			Path: "/spec",
+			Old: map[string]interface{}{
+				"replicas": 1,
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app":                    "web",
+							"app.kubernetes.io/tier": "frontend",
+						},
+					},
+				},
+			},
+			New: map[string]interface{}{
+				"replicas": 3,
+				"paused":   true,
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/tier": "backend",
+						},
+					},
+				},
+			},
+			ExpectedOps: []PatchOperation{
+				&AddOperation{
+					Path:  "/spec/paused",
+					Value: true,
+				},
+				&ReplaceOperation{
+					Path:  "/spec/replicas",
+					Value: json.Number("3"),
+				},
+				&RemoveOperation{
+					Path: "/spec/template/metadata/labels/app",
+				},
+				&ReplaceOperation{
+					Path:  "/spec/template/metadata/labels/app.kubernetes.io~1tier",
+					Value: "backend",
+				},
+			},
+		},
+		{
+			Path: "/spec/",
+			Old: map[string]interface{}{
+				"args": []interface{}{"-listen=:80", "-text=before"},
+			},
+			New: map[string]interface{}{
+				"args": []interface{}{"-listen=:80", "-text=after", "-debug"},
+			},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path:  "/spec/args/1",
+					Value: "-text=after",
+				},
+				&AddOperation{
+					Path:  "/spec/args/2",
+					Value: "-debug",
+				},
+			},
+		},
+		{
+			Path: "/spec",
+			Old: map[string]interface{}{
+				"args": []interface{}{"one", "two", "three"},
+			},
+			New: map[string]interface{}{
+				"args": []interface{}{"one"},
+			},
+			ExpectedOps: []PatchOperation{
+				&RemoveOperation{
+					Path: "/spec/args/2",
+				},
+				&RemoveOperation{
+					Path: "/spec/args/1",
+				},
+			},
+		},
+		{
+			// A container inserted between existing ones
+			Path: "/spec/template/spec/containers",
+			Old: []v1.Container{
+				{Name: "app", Image: "nginx"},
+				{Name: "proxy", Image: "envoy"},
+			},
+			New: []v1.Container{
+				{Name: "app", Image: "nginx"},
+				{Name: "logger", Image: "fluentd"},
+				{Name: "proxy", Image: "envoy"},
+			},
+			ExpectedOps: []PatchOperation{
+				&AddOperation{
+					Path: "/spec/template/spec/containers/1",
+					Value: map[string]interface{}{
+						"name":      "logger",
+						"image":     "fluentd",
+						"resources": map[string]interface{}{},
+					},
+				},
+			},
+		},
+		{
+			// Ports are matched by name, the removed one shifts the rest
+			Path: "/spec/containers/0/ports",
+			Old: []v1.ContainerPort{
+				{Name: "http", ContainerPort: 80},
+				{Name: "metrics", ContainerPort: 9090},
+				{Name: "https", ContainerPort: 443},
+			},
+			New: []v1.ContainerPort{
+				{Name: "http", ContainerPort: 80},
+				{Name: "https", ContainerPort: 8443},
+			},
+			ExpectedOps: []PatchOperation{
+				&RemoveOperation{
+					Path: "/spec/containers/0/ports/1",
+				},
+				&ReplaceOperation{
+					Path:  "/spec/containers/0/ports/1/containerPort",
+					Value: json.Number("8443"),
+				},
+			},
+		},
+		{
+			// Reordered elements can't be expressed cheaper than a replace
+			Path: "/env",
+			Old: []v1.EnvVar{
+				{Name: "A", Value: "1"},
+				{Name: "B", Value: "2"},
+			},
+			New: []v1.EnvVar{
+				{Name: "B", Value: "2"},
+				{Name: "A", Value: "1"},
+			},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path: "/env",
+					Value: []interface{}{
+						map[string]interface{}{"name": "B", "value": "2"},
+						map[string]interface{}{"name": "A", "value": "1"},
+					},
+				},
+			},
+		},
+		{
+			// Sets from state are compared by their elements
+			Path:        "/spec/accessModes",
+			Old:         newStringSet(schema.HashString, []string{"ReadWriteOnce"}),
+			New:         newStringSet(schema.HashString, []string{"ReadWriteOnce"}),
+			ExpectedOps: []PatchOperation{},
+		},
+		{
+			Path: "/spec",
+			Old: v1.PodSpec{
+				Containers:    []v1.Container{{Name: "app", Image: "nginx:1.7.8"}},
+				RestartPolicy: v1.RestartPolicyAlways,
+			},
+			New: v1.PodSpec{
+				Containers:    []v1.Container{{Name: "app", Image: "nginx:1.7.9"}},
+				RestartPolicy: v1.RestartPolicyAlways,
+			},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path:  "/spec/containers/0/image",
+					Value: "nginx:1.7.9",
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			ops, err := diffObjects(tc.Path, tc.Old, tc.New)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tc.ExpectedOps.Equal(ops) {
+				t.Fatalf("Operations don't match.\nExpected: %v\nGiven:    %v\n", tc.ExpectedOps, ops)
+			}
+		})
+	}
+}
+
+func TestDiffObjectsOrder(t *testing.T) {
+	// Operations are applied one after another, so removals have to come
+	// before changes to the elements that moved up in their place
+	oldContainers := []v1.Container{
+		{Name: "a", Image: "a:1"},
+		{Name: "b", Image: "b:1"},
+		{Name: "c", Image: "c:1"},
+	}
+	newContainers := []v1.Container{
+		{Name: "c", Image: "c:2"},
+		{Name: "d", Image: "d:1"},
+	}
+	ops, err := diffObjects("/containers", oldContainers, newContainers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `[{"path":"/containers/1","op":"remove"},` +
+		`{"path":"/containers/0","op":"remove"},` +
+		`{"path":"/containers/0/image","value":"c:2","op":"replace"},` +
+		`{"path":"/containers/1","value":{"image":"d:1","name":"d","resources":{}},"op":"add"}]`
+	if string(data) != expected {
+		t.Fatalf("Operations don't match.\nExpected: %s\nGiven:    %s\n", expected, data)
+	}
+}
+
 func TestEscapeJsonPointer(t *testing.T) {
 	testCases := []struct {
 		Input          string
@@ -101,6 +101,24 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("KUBE_LOAD_CONFIG_FILE", true),
 				Description: "Load local kubeconfig.",
 			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_NAMESPACE", ""),
+				Description: "The namespace of namespaced objects which don't specify one. Defaults to the namespace of the kubeconfig context, or `default`.",
+			},
+			"retry_on_conflict": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_RETRY_ON_CONFLICT", true),
+				Description: "Whether to retry updates of objects which were changed by someone else since they were last read. Retried updates are recomputed against the object as read again. When disabled, such updates fail instead.",
+			},
+			"strategic_merge_patch": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_STRATEGIC_MERGE_PATCH", false),
+				Description: "Whether to update pods, replication controllers and deployments with strategic merge patches, which identify list entries such as containers by name rather than by position.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -109,31 +127,60 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"kubernetes_cluster_role":              resourceKubernetesClusterRole(),
+			"kubernetes_cluster_role_binding":      resourceKubernetesClusterRoleBinding(),
 			"kubernetes_config_map":                resourceKubernetesConfigMap(),
+			"kubernetes_cron_job":                  resourceKubernetesCronJob(),
+			"kubernetes_daemon_set":                resourceKubernetesDaemonSet(),
+			"kubernetes_deployment":                resourceKubernetesDeployment(),
+			"kubernetes_endpoints":                 resourceKubernetesEndpoints(),
 			"kubernetes_horizontal_pod_autoscaler": resourceKubernetesHorizontalPodAutoscaler(),
+			"kubernetes_ingress":                   resourceKubernetesIngress(),
+			"kubernetes_job":                       resourceKubernetesJob(),
 			"kubernetes_limit_range":               resourceKubernetesLimitRange(),
 			"kubernetes_namespace":                 resourceKubernetesNamespace(),
+			"kubernetes_network_policy":            resourceKubernetesNetworkPolicy(),
 			"kubernetes_persistent_volume":         resourceKubernetesPersistentVolume(),
 			"kubernetes_persistent_volume_claim":   resourceKubernetesPersistentVolumeClaim(),
 			"kubernetes_pod":                       resourceKubernetesPod(),
+			"kubernetes_pod_disruption_budget":     resourceKubernetesPodDisruptionBudget(),
 			"kubernetes_replication_controller":    resourceKubernetesReplicationController(),
 			"kubernetes_resource_quota":            resourceKubernetesResourceQuota(),
+			"kubernetes_role":                      resourceKubernetesRole(),
+			"kubernetes_role_binding":              resourceKubernetesRoleBinding(),
 			"kubernetes_secret":                    resourceKubernetesSecret(),
 			"kubernetes_service":                   resourceKubernetesService(),
 			"kubernetes_service_account":           resourceKubernetesServiceAccount(),
+			"kubernetes_stateful_set":              resourceKubernetesStatefulSet(),
 			"kubernetes_storage_class":             resourceKubernetesStorageClass(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
 }
 
+// kubeProvider is the meta passed to every resource and data source
+type kubeProvider struct {
+	conn *kubernetes.Clientset
+
+	// retryOnConflict controls whether updates rejected because the object
+	// changed since it was last read are retried against the new version
+	retryOnConflict bool
+	// strategicMergePatch controls whether workloads are updated with
+	// strategic merge patches instead of JSON patches
+	strategicMergePatch bool
+	// namespace is where namespaced objects which don't specify
+	// a namespace are created and imported from
+	namespace string
+}
+
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	var cfg *restclient.Config
+	var ctxNamespace string
 	var err error
 	if d.Get("load_config_file").(bool) {
 		// Config file loading
-		cfg, err = tryLoadingConfigFile(d)
+		cfg, ctxNamespace, err = tryLoadingConfigFile(d)
 	}
 
 	if err != nil {
@@ -176,13 +223,29 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, fmt.Errorf("Failed to configure: %s", err)
 	}
 
-	return k, nil
+	namespace := d.Get("namespace").(string)
+	if namespace == "" {
+		namespace = ctxNamespace
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	log.Printf("[DEBUG] Using namespace %q for objects which don't specify one", namespace)
+
+	return &kubeProvider{
+		conn:                k,
+		retryOnConflict:     d.Get("retry_on_conflict").(bool),
+		strategicMergePatch: d.Get("strategic_merge_patch").(bool),
+		namespace:           namespace,
+	}, nil
 }
 
-func tryLoadingConfigFile(d *schema.ResourceData) (*restclient.Config, error) {
+// tryLoadingConfigFile returns the client config and the namespace
+// of the selected context, if the config file exists
+func tryLoadingConfigFile(d *schema.ResourceData) (*restclient.Config, string, error) {
 	path, err := homedir.Expand(d.Get("config_path").(string))
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	loader := &clientcmd.ClientConfigLoadingRules{
@@ -220,11 +283,15 @@ func tryLoadingConfigFile(d *schema.ResourceData) (*restclient.Config, error) {
 	if err != nil {
 		if pathErr, ok := err.(*os.PathError); ok && os.IsNotExist(pathErr.Err) {
 			log.Printf("[INFO] Unable to load config file as it doesn't exist at %q", path)
-			return nil, nil
+			return nil, "", nil
 		}
-		return nil, fmt.Errorf("Failed to load config (%s%s): %s", path, ctxSuffix, err)
+		return nil, "", fmt.Errorf("Failed to load config (%s%s): %s", path, ctxSuffix, err)
+	}
+	namespace, _, err := cc.Namespace()
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to read namespace from config (%s%s): %s", path, ctxSuffix, err)
 	}
 
 	log.Printf("[INFO] Successfully loaded config file (%s%s)", path, ctxSuffix)
-	return cfg, nil
+	return cfg, namespace, nil
 }
@@ -12,7 +12,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-google/google"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	api "k8s.io/kubernetes/pkg/api/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -55,6 +54,45 @@ func TestProvider_configure(t *testing.T) {
 	}
 }
 
+func TestProvider_configureNamespace(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Context  string
+		Env      string
+		Config   map[string]interface{}
+		Expected string
+	}{
+		{"no namespace anywhere", "gcp", "", map[string]interface{}{}, "default"},
+		{"kubeconfig context", "team", "", map[string]interface{}{}, "team-a"},
+		{"env var", "team", "team-b", map[string]interface{}{}, "team-b"},
+		{"provider argument", "team", "team-b", map[string]interface{}{"namespace": "team-c"}, "team-c"},
+	}
+
+	for _, tc := range testCases {
+		resetEnv := unsetEnv(t)
+		os.Setenv("KUBECONFIG", "test-fixtures/kube-config.yaml")
+		os.Setenv("KUBE_CTX", tc.Context)
+		if tc.Env != "" {
+			os.Setenv("KUBE_NAMESPACE", tc.Env)
+		}
+
+		c, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := Provider().(*schema.Provider)
+		err = p.Configure(terraform.NewResourceConfig(c))
+		resetEnv()
+		if err != nil {
+			t.Fatalf("%s: %s", tc.Name, err)
+		}
+		namespace := p.Meta().(*kubeProvider).namespace
+		if namespace != tc.Expected {
+			t.Fatalf("%s: expected namespace %q, got %q", tc.Name, tc.Expected, namespace)
+		}
+	}
+}
+
 func unsetEnv(t *testing.T) func() {
 	e := getEnv()
 
@@ -91,6 +129,9 @@ func unsetEnv(t *testing.T) func() {
 	if err := os.Unsetenv("KUBE_CLUSTER_CA_CERT_DATA"); err != nil {
 		t.Fatalf("Error unsetting env var KUBE_CLUSTER_CA_CERT_DATA: %s", err)
 	}
+	if err := os.Unsetenv("KUBE_NAMESPACE"); err != nil {
+		t.Fatalf("Error unsetting env var KUBE_NAMESPACE: %s", err)
+	}
 
 	return func() {
 		if err := os.Setenv("KUBE_CONFIG", e.Config); err != nil {
@@ -126,6 +167,9 @@ func unsetEnv(t *testing.T) func() {
 		if err := os.Setenv("KUBE_CLUSTER_CA_CERT_DATA", e.ClusterCACertData); err != nil {
 			t.Fatalf("Error resetting env var KUBE_CLUSTER_CA_CERT_DATA: %s", err)
 		}
+		if err := os.Setenv("KUBE_NAMESPACE", e.Namespace); err != nil {
+			t.Fatalf("Error resetting env var KUBE_NAMESPACE: %s", err)
+		}
 	}
 }
 
@@ -140,6 +184,7 @@ func getEnv() *currentEnv {
 		ClientCertData:    os.Getenv("KUBE_CLIENT_CERT_DATA"),
 		ClientKeyData:     os.Getenv("KUBE_CLIENT_KEY_DATA"),
 		ClusterCACertData: os.Getenv("KUBE_CLUSTER_CA_CERT_DATA"),
+		Namespace:         os.Getenv("KUBE_NAMESPACE"),
 	}
 	if cfg := os.Getenv("KUBE_CONFIG"); cfg != "" {
 		e.Config = cfg
@@ -238,7 +283,7 @@ func getFirstNode() (api.Node, error) {
 	if meta == nil {
 		return api.Node{}, errors.New("Provider not initialized, unable to get cluster node")
 	}
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 	resp, err := conn.CoreV1().Nodes().List(metav1.ListOptions{})
 	if err != nil {
 		return api.Node{}, err
@@ -262,4 +307,5 @@ type currentEnv struct {
 	ClientCertData    string
 	ClientKeyData     string
 	ClusterCACertData string
+	Namespace         string
 }
@@ -0,0 +1,390 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	api "k8s.io/kubernetes/pkg/api/v1"
+	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+)
+
+// rollingUpdateHashKey is the label added to the selector and pods of the
+// replication controllers taking part in a rolling update, so that the pods
+// of the old and new template can be told apart
+const rollingUpdateHashKey = "terraform.io/rolling-update-hash"
+
+type rollingUpdateConfig struct {
+	MaxUnavailable intstr.IntOrString
+	Timeout        time.Duration
+	UpdatePeriod   time.Duration
+	// Deadline bounds the whole update, if set
+	Deadline time.Time
+}
+
+// stepTimeout returns how long the next step of the update may take,
+// which is at most the time left until the deadline
+func (c *rollingUpdateConfig) stepTimeout() time.Duration {
+	if c.Deadline.IsZero() {
+		return c.Timeout
+	}
+	left := c.Deadline.Sub(time.Now())
+	if left < c.Timeout {
+		return left
+	}
+	return c.Timeout
+}
+
+// checkDeadline returns an error once the deadline of the update passed
+func (c *rollingUpdateConfig) checkDeadline() error {
+	if !c.Deadline.IsZero() && !time.Now().Before(c.Deadline) {
+		return fmt.Errorf("Rolling update timed out, the update timeout of the resource was reached")
+	}
+	return nil
+}
+
+func expandRollingUpdateConfig(l []interface{}) (*rollingUpdateConfig, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &rollingUpdateConfig{
+		MaxUnavailable: expandPort(in["max_unavailable"].(string)),
+	}
+	var err error
+	obj.Timeout, err = time.ParseDuration(in["timeout"].(string))
+	if err != nil {
+		return nil, err
+	}
+	obj.UpdatePeriod, err = time.ParseDuration(in["update_period"].(string))
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// rollingUpdateReplicationController replaces the pods of the replication
+// controller with ones created from the template in spec, the way
+// `kubectl rolling-update` does: a new replication controller is created
+// next to the existing one and scaled up step by step while the existing one
+// is scaled down. Once all pods are replaced, the new replication controller
+// takes over the name of the existing one.
+func rollingUpdateReplicationController(conn *kubernetes.Clientset, metadata metav1.ObjectMeta, spec api.ReplicationControllerSpec, cfg *rollingUpdateConfig) error {
+	rcs := conn.CoreV1().ReplicationControllers(metadata.Namespace)
+
+	oldRC, err := rcs.Get(metadata.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	newHash, err := podTemplateHash(spec.Template)
+	if err != nil {
+		return err
+	}
+	if oldRC.Spec.Selector[rollingUpdateHashKey] == newHash {
+		log.Printf("[DEBUG] Replication controller %s already runs the desired template", metadata.Name)
+		return nil
+	}
+	oldRC, err = addHashToReplicationController(conn, oldRC, cfg.stepTimeout())
+	if err != nil {
+		return err
+	}
+
+	spec = withPodTemplateHash(spec, newHash)
+	desired := *spec.Replicas
+	newRC := &api.ReplicationController{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%s", metadata.Name, newHash),
+			Namespace:   metadata.Namespace,
+			Labels:      metadata.Labels,
+			Annotations: metadata.Annotations,
+		},
+		Spec: spec,
+	}
+	newRC.Spec.Replicas = ptrToInt32(0)
+	log.Printf("[INFO] Creating replication controller %s to replace %s", newRC.Name, oldRC.Name)
+	out, err := rcs.Create(newRC)
+	if err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("Failed to create replication controller %s: %s", newRC.Name, err)
+		}
+		// Left behind by an interrupted rolling update
+		out, err = rcs.Get(newRC.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	newRC = out
+
+	// Percentages are rounded up so that each step replaces at least one pod
+	maxUnavailable, err := intstr.GetValueFromIntOrPercent(&cfg.MaxUnavailable, int(desired), true)
+	if err != nil {
+		return err
+	}
+	step := int32(maxUnavailable)
+	if desired == 0 {
+		// Nothing to replace, the old pods only need to be removed
+		step = *oldRC.Spec.Replicas
+	}
+
+	for *newRC.Spec.Replicas < desired || *oldRC.Spec.Replicas > 0 {
+		if err := cfg.checkDeadline(); err != nil {
+			return err
+		}
+		oldReplicas := *oldRC.Spec.Replicas - step
+		if oldReplicas < 0 || *newRC.Spec.Replicas+step >= desired {
+			oldReplicas = 0
+		}
+		oldRC, err = scaleReplicationController(conn, oldRC, oldReplicas, cfg.stepTimeout())
+		if err != nil {
+			return err
+		}
+
+		newReplicas := *newRC.Spec.Replicas + step
+		if newReplicas > desired {
+			newReplicas = desired
+		}
+		newRC, err = scaleReplicationController(conn, newRC, newReplicas, cfg.stepTimeout())
+		if err != nil {
+			return err
+		}
+		err = resource.Retry(cfg.stepTimeout(), waitForReadyReplicasFunc(conn, newRC.Namespace, newRC.Name))
+		if err != nil {
+			return withLastWarnings(conn, newRC, err)
+		}
+		logRollingUpdateProgress(conn, newRC, fmt.Sprintf("Rolling update of %s: %d of %d replicas updated, %d old replicas left",
+			metadata.Name, newReplicas, desired, oldReplicas))
+
+		if *newRC.Spec.Replicas < desired || *oldRC.Spec.Replicas > 0 {
+			time.Sleep(cfg.UpdatePeriod)
+		}
+	}
+
+	if err := cfg.checkDeadline(); err != nil {
+		return err
+	}
+	return renameReplicationController(conn, oldRC, newRC, cfg)
+}
+
+// addHashToReplicationController labels the replication controller's
+// template, pods and selector with the hash of its template, so that its
+// selector won't overlap with the one of the replication controller
+// replacing it
+func addHashToReplicationController(conn *kubernetes.Clientset, rc *api.ReplicationController, timeout time.Duration) (*api.ReplicationController, error) {
+	if _, ok := rc.Spec.Selector[rollingUpdateHashKey]; ok {
+		return rc, nil
+	}
+	hash, err := podTemplateHash(rc.Spec.Template)
+	if err != nil {
+		return nil, err
+	}
+	path := "/" + escapeJsonPointer(rollingUpdateHashKey)
+
+	log.Printf("[INFO] Labelling template of replication controller %s with %s=%s", rc.Name, rollingUpdateHashKey, hash)
+	labelPath := "/spec/template/metadata/labels"
+	if len(rc.Spec.Template.Labels) == 0 {
+		rc, err = patchReplicationController(conn, rc, &AddOperation{Path: labelPath, Value: map[string]string{}})
+		if err != nil {
+			return nil, err
+		}
+	}
+	rc, err = patchReplicationController(conn, rc, &AddOperation{Path: labelPath + path, Value: hash})
+	if err != nil {
+		return nil, err
+	}
+
+	selector := labels.SelectorFromSet(labels.Set(rc.Spec.Selector)).String()
+	pods, err := conn.CoreV1().Pods(rc.Namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		if pod.Labels[rollingUpdateHashKey] == hash {
+			continue
+		}
+		data, err := PatchOperations{&AddOperation{Path: "/metadata/labels" + path, Value: hash}}.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[DEBUG] Labelling pod %s with %s=%s", pod.Name, rollingUpdateHashKey, hash)
+		_, err = conn.CoreV1().Pods(pod.Namespace).Patch(pod.Name, pkgApi.JSONPatchType, data)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	rc, err = patchReplicationController(conn, rc, &AddOperation{Path: "/spec/selector" + path, Value: hash})
+	if err != nil {
+		return nil, err
+	}
+	err = resource.Retry(timeout, waitForDesiredReplicasFunc(conn, rc.Namespace, rc.Name))
+	if err != nil {
+		return nil, withLastWarnings(conn, rc, err)
+	}
+	return rc, nil
+}
+
+// renameReplicationController hands the pods of newRC over to a replication
+// controller created with the name, finalizers and owner references of oldRC,
+// which is deleted first
+func renameReplicationController(conn *kubernetes.Clientset, oldRC, newRC *api.ReplicationController, cfg *rollingUpdateConfig) error {
+	rcs := conn.CoreV1().ReplicationControllers(oldRC.Namespace)
+
+	log.Printf("[INFO] Deleting replication controller %s", oldRC.Name)
+	err := rcs.Delete(oldRC.Name, &metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	err = resource.Retry(cfg.stepTimeout(), waitForReplicationControllerDeletedFunc(conn, oldRC.Namespace, oldRC.Name))
+	if err != nil {
+		return err
+	}
+
+	// Keep the pods running while they're moved to the renamed controller
+	orphan := metav1.DeletePropagationOrphan
+	log.Printf("[INFO] Renaming replication controller %s to %s", newRC.Name, oldRC.Name)
+	err = rcs.Delete(newRC.Name, &metav1.DeleteOptions{PropagationPolicy: &orphan})
+	if err != nil {
+		return err
+	}
+	err = resource.Retry(cfg.stepTimeout(), waitForReplicationControllerDeletedFunc(conn, newRC.Namespace, newRC.Name))
+	if err != nil {
+		return err
+	}
+
+	rc := &api.ReplicationController{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            oldRC.Name,
+			Namespace:       newRC.Namespace,
+			Labels:          newRC.Labels,
+			Annotations:     newRC.Annotations,
+			Finalizers:      oldRC.Finalizers,
+			OwnerReferences: oldRC.OwnerReferences,
+		},
+		Spec: newRC.Spec,
+	}
+	out, err := rcs.Create(rc)
+	if err != nil {
+		return fmt.Errorf("Failed to create replication controller %s: %s", rc.Name, err)
+	}
+	err = resource.Retry(cfg.stepTimeout(), waitForDesiredReplicasFunc(conn, out.Namespace, out.Name))
+	if err != nil {
+		return withLastWarnings(conn, out, err)
+	}
+	return nil
+}
+
+func scaleReplicationController(conn *kubernetes.Clientset, rc *api.ReplicationController, replicas int32, timeout time.Duration) (*api.ReplicationController, error) {
+	if *rc.Spec.Replicas == replicas {
+		return rc, nil
+	}
+	log.Printf("[INFO] Scaling replication controller %s from %d to %d replicas", rc.Name, *rc.Spec.Replicas, replicas)
+	out, err := patchReplicationController(conn, rc, &ReplaceOperation{Path: "/spec/replicas", Value: replicas})
+	if err != nil {
+		return nil, err
+	}
+	err = resource.Retry(timeout, waitForDesiredReplicasFunc(conn, out.Namespace, out.Name))
+	if err != nil {
+		return nil, withLastWarnings(conn, out, err)
+	}
+	return out, nil
+}
+
+func patchReplicationController(conn *kubernetes.Clientset, rc *api.ReplicationController, ops ...PatchOperation) (*api.ReplicationController, error) {
+	data, err := PatchOperations(ops).MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	out, err := conn.CoreV1().ReplicationControllers(rc.Namespace).Patch(rc.Name, pkgApi.JSONPatchType, data)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to update replication controller %s: %s", rc.Name, err)
+	}
+	return out, nil
+}
+
+func waitForReadyReplicasFunc(conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
+	return func() *resource.RetryError {
+		rc, err := conn.CoreV1().ReplicationControllers(ns).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		desiredReplicas := *rc.Spec.Replicas
+		if rc.Status.ObservedGeneration >= rc.Generation && rc.Status.ReadyReplicas >= desiredReplicas {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("Waiting for %d replicas of %q to be ready (%d)",
+			desiredReplicas, rc.GetName(), rc.Status.ReadyReplicas))
+	}
+}
+
+func waitForReplicationControllerDeletedFunc(conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
+	return func() *resource.RetryError {
+		_, err := conn.CoreV1().ReplicationControllers(ns).Get(name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("Replication controller %s still exists", name))
+	}
+}
+
+func withLastWarnings(conn *kubernetes.Clientset, rc *api.ReplicationController, err error) error {
+	lastWarnings, wErr := getLastWarningsForObject(conn, rc.ObjectMeta, "ReplicationController", 3)
+	if wErr == nil {
+		err = fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+	}
+	return err
+}
+
+// logRollingUpdateProgress logs the progress of a rolling update along with
+// the last warnings of the replication controller being scaled up, so that
+// problems with the new template show up before the update times out
+func logRollingUpdateProgress(conn *kubernetes.Clientset, rc *api.ReplicationController, progress string) {
+	lastWarnings, err := getLastWarningsForObject(conn, rc.ObjectMeta, "ReplicationController", 3)
+	if err != nil {
+		log.Printf("[WARN] Failed to list events of replication controller %s: %s", rc.Name, err)
+	}
+	if len(lastWarnings) > 0 {
+		log.Printf("[WARN] %s%s", progress, stringifyEvents(lastWarnings))
+		return
+	}
+	log.Printf("[INFO] %s", progress)
+}
+
+// podTemplateHash returns a short hash identifying the pod template
+func podTemplateHash(template *api.PodTemplateSpec) (string, error) {
+	b, err := json.Marshal(template.Spec)
+	if err != nil {
+		return "", err
+	}
+	h := fnv.New32a()
+	h.Write(b)
+	return fmt.Sprintf("%x", h.Sum32()), nil
+}
+
+// withPodTemplateHash returns spec with the template hash added to its
+// selector and template labels
+func withPodTemplateHash(spec api.ReplicationControllerSpec, hash string) api.ReplicationControllerSpec {
+	selector := make(map[string]string, len(spec.Selector)+1)
+	for k, v := range spec.Selector {
+		selector[k] = v
+	}
+	selector[rollingUpdateHashKey] = hash
+	spec.Selector = selector
+
+	template := *spec.Template
+	template.Labels = selector
+	spec.Template = &template
+	return spec
+}
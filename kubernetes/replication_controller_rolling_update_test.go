@@ -0,0 +1,127 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	api "k8s.io/kubernetes/pkg/api/v1"
+)
+
+func TestExpandRollingUpdateConfig(t *testing.T) {
+	cfg, err := expandRollingUpdateConfig([]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg != nil {
+		t.Fatalf("Expected no rolling update without a block, got %#v", cfg)
+	}
+
+	cfg, err = expandRollingUpdateConfig([]interface{}{
+		map[string]interface{}{
+			"max_unavailable": "25%",
+			"timeout":         "5m",
+			"update_period":   "30s",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &rollingUpdateConfig{
+		MaxUnavailable: intstr.FromString("25%"),
+		Timeout:        5 * time.Minute,
+		UpdatePeriod:   30 * time.Second,
+	}
+	if !reflect.DeepEqual(expected, cfg) {
+		t.Fatalf("Expected %#v, got %#v", expected, cfg)
+	}
+}
+
+func TestRollingUpdateConfigStepTimeout(t *testing.T) {
+	cfg := &rollingUpdateConfig{Timeout: 5 * time.Minute}
+	if cfg.stepTimeout() != 5*time.Minute {
+		t.Fatalf("Expected the step timeout without a deadline, got %s", cfg.stepTimeout())
+	}
+	if err := cfg.checkDeadline(); err != nil {
+		t.Fatalf("Expected no deadline, got %s", err)
+	}
+
+	cfg.Deadline = time.Now().Add(time.Minute)
+	if timeout := cfg.stepTimeout(); timeout > time.Minute || timeout < 50*time.Second {
+		t.Fatalf("Expected the step timeout to be bounded by the deadline, got %s", timeout)
+	}
+
+	cfg.Deadline = time.Now().Add(-time.Second)
+	if err := cfg.checkDeadline(); err == nil {
+		t.Fatal("Expected the passed deadline to fail the update")
+	}
+}
+
+func TestPodTemplateHash(t *testing.T) {
+	template := func(image string) *api.PodTemplateSpec {
+		return &api.PodTemplateSpec{
+			Spec: api.PodSpec{
+				Containers: []api.Container{{Name: "app", Image: image}},
+			},
+		}
+	}
+
+	h1, err := podTemplateHash(template("nginx:1.7.8"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2, err := podTemplateHash(template("nginx:1.7.8"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h3, err := podTemplateHash(template("nginx:1.7.9"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h1 != h2 {
+		t.Fatalf("Expected equal templates to have the same hash, got %q and %q", h1, h2)
+	}
+	if h1 == h3 {
+		t.Fatalf("Expected different templates to have different hashes, both got %q", h1)
+	}
+}
+
+func TestWithPodTemplateHash(t *testing.T) {
+	selector := map[string]string{"app": "web"}
+	spec := api.ReplicationControllerSpec{
+		Selector: selector,
+		Template: &api.PodTemplateSpec{},
+	}
+	spec.Template.Labels = selector
+
+	out := withPodTemplateHash(spec, "abc123")
+
+	expected := map[string]string{"app": "web", rollingUpdateHashKey: "abc123"}
+	if !reflect.DeepEqual(expected, out.Selector) {
+		t.Fatalf("Expected selector %#v, got %#v", expected, out.Selector)
+	}
+	if !reflect.DeepEqual(expected, out.Template.Labels) {
+		t.Fatalf("Expected template labels %#v, got %#v", expected, out.Template.Labels)
+	}
+	if len(spec.Selector) != 1 || len(spec.Template.Labels) != 1 {
+		t.Fatalf("Expected original spec to be left untouched, got %#v", spec)
+	}
+}
+
+func TestFlattenReplicationControllerSpecHidesTemplateHash(t *testing.T) {
+	spec := api.ReplicationControllerSpec{
+		Replicas: ptrToInt32(1),
+		Selector: map[string]string{"app": "web", rollingUpdateHashKey: "abc123"},
+		Template: &api.PodTemplateSpec{},
+	}
+	flattened, err := flattenReplicationControllerSpec(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	selector := flattened[0].(map[string]interface{})["selector"]
+	expected := map[string]string{"app": "web"}
+	if !reflect.DeepEqual(expected, selector) {
+		t.Fatalf("Expected selector %#v, got %#v", expected, selector)
+	}
+}
@@ -0,0 +1,136 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	api "k8s.io/kubernetes/pkg/apis/rbac/v1beta1"
+)
+
+func resourceKubernetesClusterRoleBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesClusterRoleBindingCreate,
+		Read:   resourceKubernetesClusterRoleBindingRead,
+		Exists: resourceKubernetesClusterRoleBindingExists,
+		Update: resourceKubernetesClusterRoleBindingUpdate,
+		Delete: resourceKubernetesClusterRoleBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("cluster role binding", true),
+			"role_ref": roleRefSchema([]string{"ClusterRole"}),
+			"subject":  subjectSchema(),
+		},
+	}
+}
+
+func resourceKubernetesClusterRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	binding := api.ClusterRoleBinding{
+		ObjectMeta: metadata,
+		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
+		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
+	}
+	log.Printf("[INFO] Creating new cluster role binding: %#v", binding)
+	out, err := conn.RbacV1beta1().ClusterRoleBindings().Create(&binding)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new cluster role binding: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesClusterRoleBindingRead(d, meta)
+}
+
+func resourceKubernetesClusterRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Reading cluster role binding %s", name)
+	binding, err := conn.RbacV1beta1().ClusterRoleBindings().Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received cluster role binding: %#v", binding)
+	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+	err = d.Set("role_ref", flattenRBACRoleRef(binding.RoleRef))
+	if err != nil {
+		return err
+	}
+	err = d.Set("subject", flattenRBACSubjects(binding.Subjects))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesClusterRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	name := d.Id()
+	var out *api.ClusterRoleBinding
+	err := patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		diffOps, err := patchRBACSubjects("", "/", d)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, diffOps...)
+		return ops, nil
+	}, resourceKubernetesClusterRoleBindingRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating cluster role binding %q: %v", name, string(data))
+		out, err = conn.RbacV1beta1().ClusterRoleBindings().Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update cluster role binding: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated cluster role binding: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesClusterRoleBindingRead(d, meta)
+}
+
+func resourceKubernetesClusterRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting cluster role binding: %#v", name)
+	err := conn.RbacV1beta1().ClusterRoleBindings().Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Cluster role binding %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesClusterRoleBindingExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubeProvider).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Checking cluster role binding %s", name)
+	_, err := conn.RbacV1beta1().ClusterRoleBindings().Get(name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
@@ -0,0 +1,154 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/kubernetes/pkg/apis/rbac/v1beta1"
+)
+
+func TestAccKubernetesClusterRole_basic(t *testing.T) {
+	var conf api.ClusterRole
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_cluster_role.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesClusterRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesClusterRoleConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesClusterRoleExists("kubernetes_cluster_role.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_cluster_role.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_cluster_role.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_cluster_role.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_cluster_role.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "rule.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "rule.0.api_groups.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "rule.0.resources.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "rule.1.non_resource_urls.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "rule.1.verbs.#", "1"),
+				),
+			},
+			{
+				Config: testAccKubernetesClusterRoleConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesClusterRoleExists("kubernetes_cluster_role.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "rule.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "rule.0.resources.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "rule.0.verbs.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesClusterRole_importBasic(t *testing.T) {
+	resourceName := "kubernetes_cluster_role.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesClusterRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesClusterRoleConfig_basic(name),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesClusterRoleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubeProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_cluster_role" {
+			continue
+		}
+		name := rs.Primary.ID
+		resp, err := conn.RbacV1beta1().ClusterRoles().Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Cluster role still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesClusterRoleExists(n string, obj *api.ClusterRole) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubeProvider).conn
+		name := rs.Primary.ID
+		out, err := conn.RbacV1beta1().ClusterRoles().Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesClusterRoleConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_cluster_role" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    name = "%s"
+  }
+  rule {
+    api_groups = [""]
+    resources  = ["namespaces", "nodes"]
+    verbs      = ["get", "list"]
+  }
+  rule {
+    non_resource_urls = ["/healthz"]
+    verbs             = ["get"]
+  }
+}
+`, name)
+}
+
+func testAccKubernetesClusterRoleConfig_modified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_cluster_role" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    name = "%s"
+  }
+  rule {
+    api_groups = [""]
+    resources  = ["nodes"]
+    verbs      = ["get"]
+  }
+}
+`, name)
+}
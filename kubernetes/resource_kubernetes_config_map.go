@@ -9,7 +9,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	api "k8s.io/kubernetes/pkg/api/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 func resourceKubernetesConfigMap() *schema.Resource {
@@ -20,7 +19,7 @@ func resourceKubernetesConfigMap() *schema.Resource {
 		Update: resourceKubernetesConfigMapUpdate,
 		Delete: resourceKubernetesConfigMapDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importNamespacedState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,9 +34,9 @@ func resourceKubernetesConfigMap() *schema.Resource {
 }
 
 func resourceKubernetesConfigMapCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandNamespacedMetadata(d, meta)
 	cfgMap := api.ConfigMap{
 		ObjectMeta: metadata,
 		Data:       expandStringMap(d.Get("data").(map[string]interface{})),
@@ -54,7 +53,7 @@ func resourceKubernetesConfigMapCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesConfigMapRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -67,7 +66,7 @@ func resourceKubernetesConfigMapRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 	log.Printf("[INFO] Received config map: %#v", cfgMap)
-	err = d.Set("metadata", flattenMetadata(cfgMap.ObjectMeta))
+	err = d.Set("metadata", flattenMetadata(cfgMap.ObjectMeta, d))
 	if err != nil {
 		return err
 	}
@@ -77,25 +76,27 @@ func resourceKubernetesConfigMapRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesConfigMapUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	if d.HasChange("data") {
-		oldV, newV := d.GetChange("data")
-		diffOps := diffStringMap("/data/", oldV.(map[string]interface{}), newV.(map[string]interface{}))
-		ops = append(ops, diffOps...)
-	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating config map %q: %v", name, string(data))
-	out, err := conn.CoreV1().ConfigMaps(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	var out *api.ConfigMap
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		if d.HasChange("data") {
+			oldV, newV := d.GetChange("data")
+			diffOps := diffStringMap("/data/", oldV.(map[string]interface{}), newV.(map[string]interface{}))
+			ops = append(ops, diffOps...)
+		}
+		return ops, nil
+	}, resourceKubernetesConfigMapRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating config map %q: %v", name, string(data))
+		out, err = conn.CoreV1().ConfigMaps(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update Config Map: %s", err)
 	}
@@ -106,7 +107,7 @@ func resourceKubernetesConfigMapUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesConfigMapDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -125,7 +126,7 @@ func resourceKubernetesConfigMapDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesConfigMapExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	api "k8s.io/kubernetes/pkg/api/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 func TestAccKubernetesConfigMap_basic(t *testing.T) {
@@ -93,6 +92,42 @@ func TestAccKubernetesConfigMap_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesConfigMap_ownerReference(t *testing.T) {
+	var conf api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_config_map.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapConfig_ownerReference(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists("kubernetes_config_map.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.owner_reference.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.owner_reference.0.api_version", "v1"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.owner_reference.0.block_owner_deletion", "true"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.owner_reference.0.kind", "ConfigMap"),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.owner_reference.0.name", name+"-parent"),
+					resource.TestCheckResourceAttrPair("kubernetes_config_map.test", "metadata.0.owner_reference.0.uid",
+						"kubernetes_config_map.parent", "metadata.0.uid"),
+					testAccCheckConfigMapOwners(&conf, []string{name + "-parent"}),
+				),
+			},
+			{
+				Config: testAccKubernetesConfigMapConfig_ownerReference(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists("kubernetes_config_map.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_config_map.test", "metadata.0.owner_reference.#", "0"),
+					testAccCheckConfigMapOwners(&conf, []string{}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesConfigMap_importBasic(t *testing.T) {
 	resourceName := "kubernetes_config_map.test"
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -111,6 +146,12 @@ func TestAccKubernetesConfigMap_importBasic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -180,8 +221,22 @@ func testAccCheckConfigMapData(m *api.ConfigMap, expected map[string]string) res
 	}
 }
 
+func testAccCheckConfigMapOwners(m *api.ConfigMap, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		owners := make([]string, 0, len(m.OwnerReferences))
+		for _, ref := range m.OwnerReferences {
+			owners = append(owners, ref.Name)
+		}
+		if !reflect.DeepEqual(owners, expected) {
+			return fmt.Errorf("%s owner references don't match.\nExpected: %q\nGiven: %q",
+				m.Name, expected, owners)
+		}
+		return nil
+	}
+}
+
 func testAccCheckKubernetesConfigMapDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*kubernetes.Clientset)
+	conn := testAccProvider.Meta().(*kubeProvider).conn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "kubernetes_config_map" {
@@ -209,7 +264,7 @@ func testAccCheckKubernetesConfigMapExists(n string, obj *api.ConfigMap) resourc
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*kubernetes.Clientset)
+		conn := testAccProvider.Meta().(*kubeProvider).conn
 		namespace, name, err := idParts(rs.Primary.ID)
 		if err != nil {
 			return err
@@ -277,6 +332,36 @@ resource "kubernetes_config_map" "test" {
 }`, name)
 }
 
+func testAccKubernetesConfigMapConfig_ownerReference(name string, owned bool) string {
+	ownerReference := ""
+	if owned {
+		ownerReference = `
+		owner_reference {
+			api_version = "v1"
+			kind = "ConfigMap"
+			name = "${kubernetes_config_map.parent.metadata.0.name}"
+			uid = "${kubernetes_config_map.parent.metadata.0.uid}"
+			block_owner_deletion = true
+		}`
+	}
+	return fmt.Sprintf(`
+resource "kubernetes_config_map" "parent" {
+	metadata {
+		name = "%s-parent"
+	}
+}
+
+resource "kubernetes_config_map" "test" {
+	metadata {
+		name = "%s"%s
+	}
+	data {
+		one = "first"
+	}
+}
+`, name, name, ownerReference)
+}
+
 func testAccKubernetesConfigMapConfig_generatedName(prefix string) string {
 	return fmt.Sprintf(`
 resource "kubernetes_config_map" "test" {
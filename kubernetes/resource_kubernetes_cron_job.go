@@ -0,0 +1,196 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	batch_v2alpha1 "k8s.io/kubernetes/pkg/apis/batch/v2alpha1"
+)
+
+func resourceKubernetesCronJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesCronJobCreate,
+		Read:   resourceKubernetesCronJobRead,
+		Exists: resourceKubernetesCronJobExists,
+		Update: resourceKubernetesCronJobUpdate,
+		Delete: resourceKubernetesCronJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: importNamespacedState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("cron job", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec of the cron job owned by the cluster. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: cronJobSpecFields(),
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesCronJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	metadata := expandNamespacedMetadata(d, meta)
+	spec, err := expandCronJobSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	job := batch_v2alpha1.CronJob{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}
+
+	log.Printf("[INFO] Creating new cron job: %#v", job)
+	out, err := conn.BatchV2alpha1().CronJobs(metadata.Namespace).Create(&job)
+	if err != nil {
+		return fmt.Errorf("Failed to create cron job: %s", err)
+	}
+	log.Printf("[INFO] Submitted new cron job: %#v", out)
+
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesCronJobRead(d, meta)
+}
+
+func resourceKubernetesCronJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading cron job %s", name)
+	job, err := conn.BatchV2alpha1().CronJobs(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received cron job: %#v", job)
+
+	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	spec, err := flattenCronJobSpec(job.Spec)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("spec", spec)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesCronJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var out *batch_v2alpha1.CronJob
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		if d.HasChange("spec") {
+			diffOps, err := patchCronJobSpec("spec.0.", "/spec", d)
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, diffOps...)
+		}
+		return ops, nil
+	}, resourceKubernetesCronJobRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating cron job %q: %v", name, string(data))
+		out, err = conn.BatchV2alpha1().CronJobs(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update cron job: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated cron job: %#v", out)
+
+	return resourceKubernetesCronJobRead(d, meta)
+}
+
+func resourceKubernetesCronJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting cron job: %#v", name)
+
+	// Remove the jobs spawned by the cron job along with it
+	propagation := metav1.DeletePropagationForeground
+	err = conn.BatchV2alpha1().CronJobs(namespace).Delete(name, &metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.BatchV2alpha1().CronJobs(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("Cron job %s still exists", name)
+		return resource.RetryableError(e)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Cron job %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesCronJobExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking cron job %s", name)
+	_, err = conn.BatchV2alpha1().CronJobs(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
@@ -0,0 +1,178 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	batch_v2alpha1 "k8s.io/kubernetes/pkg/apis/batch/v2alpha1"
+)
+
+func TestAccKubernetesCronJob_basic(t *testing.T) {
+	var conf batch_v2alpha1.CronJob
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_cron_job.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesCronJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCronJobConfig_basic(name, "*/5 * * * *", "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCronJobExists("kubernetes_cron_job.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_cron_job.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_cron_job.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_cron_job.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_cron_job.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.concurrency_policy", "Forbid"),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.failed_jobs_history_limit", "1"),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.schedule", "*/5 * * * *"),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.successful_jobs_history_limit", "3"),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.suspend", "false"),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.job_template.0.spec.0.template.0.metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.job_template.0.spec.0.template.0.spec.0.container.0.image", "busybox"),
+				),
+			},
+			{
+				Config: testAccKubernetesCronJobConfig_basic(name, "@hourly", "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCronJobExists("kubernetes_cron_job.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.schedule", "@hourly"),
+					resource.TestCheckResourceAttr("kubernetes_cron_job.test", "spec.0.job_template.0.spec.0.template.0.metadata.0.labels.TestLabelOne", "two"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesCronJob_importBasic(t *testing.T) {
+	resourceName := "kubernetes_cron_job.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesCronJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCronJobConfig_basic(name, "*/5 * * * *", "one"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKubernetesCronJob_invalidSchedule(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesCronJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesCronJobConfig_basic(name, "61 * * * *", "one"),
+				ExpectError: regexp.MustCompile("out of range"),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesCronJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubeProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_cron_job" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.BatchV2alpha1().CronJobs(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Cron job still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesCronJobExists(n string, obj *batch_v2alpha1.CronJob) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubeProvider).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.BatchV2alpha1().CronJobs(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesCronJobConfig_basic(name, schedule, label string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_cron_job" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    name = "%s"
+  }
+  spec {
+    concurrency_policy = "Forbid"
+    schedule           = "%s"
+    job_template {
+      spec {
+        template {
+          metadata {
+            labels {
+              TestLabelOne = "%s"
+            }
+          }
+          spec {
+            container {
+              command = ["sh", "-c", "date"]
+              image   = "busybox"
+              name    = "tf-acc-test"
+            }
+            restart_policy = "OnFailure"
+          }
+        }
+      }
+    }
+  }
+}
+`, name, schedule, label)
+}
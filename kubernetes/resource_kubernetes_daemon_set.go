@@ -0,0 +1,309 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	ex_v1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+)
+
+func resourceKubernetesDaemonSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesDaemonSetCreate,
+		Read:   resourceKubernetesDaemonSetRead,
+		Exists: resourceKubernetesDaemonSetExists,
+		Update: resourceKubernetesDaemonSetUpdate,
+		Delete: resourceKubernetesDaemonSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: importNamespacedState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("daemon set", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the desired behavior of the daemon set. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_ready_seconds": {
+							Type:        schema.TypeInt,
+							Description: "Minimum number of seconds for which a newly created daemon pod should be ready without any of its container crashing, for it to be considered available. Defaults to 0 (pod will be considered available as soon as it is ready)",
+							Optional:    true,
+							Default:     0,
+						},
+						"selector": {
+							Type:        schema.TypeList,
+							Description: "A label query over pods that are managed by the daemon set. Must match in order to be controlled. If empty, defaulted to labels on the pod template. More info: http://kubernetes.io/docs/user-guide/labels#label-selectors",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: generateLabelSelector(false),
+							},
+						},
+						"template": {
+							Type:        schema.TypeList,
+							Description: "An object that describes the pod that will be created. The daemon set will create exactly one copy of this pod on every node that matches the template's node selector (or on every node if no node selector is specified).",
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: podTemplateFields(true),
+							},
+						},
+						"update_strategy": {
+							Type:        schema.TypeList,
+							Description: "An update strategy to replace existing daemon set pods with new pods.",
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rolling_update": {
+										Type:        schema.TypeList,
+										Description: "Rolling update config params. Present only if `type` is `RollingUpdate`.",
+										Optional:    true,
+										Computed:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"max_unavailable": {
+													Type:        schema.TypeString,
+													Description: "The maximum number of daemon set pods that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of total number of daemon set pods at the start of the update (ex: 10%). Absolute number is calculated from percentage by rounding up. This cannot be 0. Defaults to 1.",
+													Optional:    true,
+													Computed:    true,
+												},
+											},
+										},
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Description:  "Type of daemon set update. Can be `RollingUpdate` or `OnDelete`. Defaults to `OnDelete`.",
+										Optional:     true,
+										Default:      "OnDelete",
+										ValidateFunc: validateAttributeValueIsIn([]string{"RollingUpdate", "OnDelete"}),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesDaemonSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	metadata := expandNamespacedMetadata(d, meta)
+	spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	spec.Template.Spec.AutomountServiceAccountToken = ptrToBool(false)
+
+	daemonSet := ex_v1beta1.DaemonSet{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}
+
+	log.Printf("[INFO] Creating new daemon set: %#v", daemonSet)
+	out, err := conn.ExtensionsV1beta1().DaemonSets(metadata.Namespace).Create(&daemonSet)
+	if err != nil {
+		return fmt.Errorf("Failed to create daemon set: %s", err)
+	}
+
+	d.SetId(buildId(out.ObjectMeta))
+
+	log.Printf("[DEBUG] Waiting for daemon set %s to roll out", d.Id())
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate),
+		waitForDaemonSetRolloutFunc(conn, out.GetNamespace(), out.GetName()))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted new daemon set: %#v", out)
+
+	return resourceKubernetesDaemonSetRead(d, meta)
+}
+
+func resourceKubernetesDaemonSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading daemon set %s", name)
+	daemonSet, err := conn.ExtensionsV1beta1().DaemonSets(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received daemon set: %#v", daemonSet)
+
+	err = d.Set("metadata", flattenMetadata(daemonSet.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	spec, err := flattenDaemonSetSpec(daemonSet.Spec)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("spec", spec)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesDaemonSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var out *ex_v1beta1.DaemonSet
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		if d.HasChange("spec") {
+			diffOps, err := patchDaemonSetSpec("spec.0.", "/spec", d)
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, diffOps...)
+		}
+		return ops, nil
+	}, resourceKubernetesDaemonSetRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating daemon set %q: %v", name, string(data))
+		out, err = conn.ExtensionsV1beta1().DaemonSets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update daemon set: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated daemon set: %#v", out)
+
+	err = resource.Retry(d.Timeout(schema.TimeoutUpdate),
+		waitForDaemonSetRolloutFunc(conn, namespace, name))
+	if err != nil {
+		return err
+	}
+
+	return resourceKubernetesDaemonSetRead(d, meta)
+}
+
+func resourceKubernetesDaemonSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting daemon set: %#v", name)
+
+	// Daemon sets in extensions/v1beta1 orphan their pods by default
+	propagation := metav1.DeletePropagationForeground
+	err = conn.ExtensionsV1beta1().DaemonSets(namespace).Delete(name, &metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Wait until the daemon set and its pods are gone
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.ExtensionsV1beta1().DaemonSets(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("Daemon set %s still exists", name)
+		return resource.RetryableError(e)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Daemon set %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesDaemonSetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking daemon set %s", name)
+	_, err = conn.ExtensionsV1beta1().DaemonSets(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+func waitForDaemonSetRolloutFunc(conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
+	return func() *resource.RetryError {
+		daemonSet, err := conn.ExtensionsV1beta1().DaemonSets(ns).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if daemonSet.Generation > daemonSet.Status.ObservedGeneration {
+			return resource.RetryableError(fmt.Errorf("Waiting for rollout of daemon set %q to start", name))
+		}
+
+		status := daemonSet.Status
+		log.Printf("[DEBUG] Current number of ready pods of %q: %d, updated: %d (of %d)\n",
+			name, status.NumberReady, status.UpdatedNumberScheduled, status.DesiredNumberScheduled)
+
+		// Pods of an OnDelete daemon set are only replaced once deleted manually,
+		// so waiting for them to be updated would never finish
+		if daemonSet.Spec.UpdateStrategy.Type != ex_v1beta1.OnDeleteDaemonSetStrategyType &&
+			status.UpdatedNumberScheduled != status.DesiredNumberScheduled {
+			return resource.RetryableError(fmt.Errorf("Waiting for rollout of %q to finish: %d out of %d new pods have been updated",
+				name, status.UpdatedNumberScheduled, status.DesiredNumberScheduled))
+		}
+		if status.NumberReady != status.DesiredNumberScheduled {
+			return resource.RetryableError(fmt.Errorf("Waiting for rollout of %q to finish: %d of %d pods are ready",
+				name, status.NumberReady, status.DesiredNumberScheduled))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,210 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ex_v1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+func TestAccKubernetesDaemonSet_basic(t *testing.T) {
+	var conf ex_v1beta1.DaemonSet
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_daemon_set.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesDaemonSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDaemonSetConfig_basic(name, "nginx:1.7.8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDaemonSetExists("kubernetes_daemon_set.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "metadata.0.labels.TestLabelOne", "one"),
+					testAccCheckMetaLabels(&conf.ObjectMeta, map[string]string{"TestLabelOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_daemon_set.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_daemon_set.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_daemon_set.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_daemon_set.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "spec.0.update_strategy.0.type", "RollingUpdate"),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "spec.0.update_strategy.0.rolling_update.0.max_unavailable", "1"),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "spec.0.template.0.spec.0.container.0.image", "nginx:1.7.8"),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "spec.0.template.0.spec.0.container.0.name", "tf-acc-test"),
+				),
+			},
+			{
+				Config: testAccKubernetesDaemonSetConfig_basic(name, "nginx:1.7.9"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDaemonSetExists("kubernetes_daemon_set.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "spec.0.template.0.spec.0.container.0.image", "nginx:1.7.9"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDaemonSet_importBasic(t *testing.T) {
+	resourceName := "kubernetes_daemon_set.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesDaemonSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDaemonSetConfig_basic(name, "nginx:1.7.8"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDaemonSet_generatedName(t *testing.T) {
+	var conf ex_v1beta1.DaemonSet
+	prefix := "tf-acc-test-gen-"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_daemon_set.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesDaemonSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDaemonSetConfig_generatedName(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDaemonSetExists("kubernetes_daemon_set.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "metadata.0.generate_name", prefix),
+					resource.TestMatchResourceAttr("kubernetes_daemon_set.test", "metadata.0.name", regexp.MustCompile("^"+prefix)),
+					resource.TestCheckResourceAttrSet("kubernetes_daemon_set.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_daemon_set.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_daemon_set.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_daemon_set.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_daemon_set.test", "spec.0.update_strategy.0.type", "OnDelete"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesDaemonSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubeProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_daemon_set" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.ExtensionsV1beta1().DaemonSets(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Daemon Set still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesDaemonSetExists(n string, obj *ex_v1beta1.DaemonSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubeProvider).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.ExtensionsV1beta1().DaemonSets(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesDaemonSetConfig_basic(name, imageName string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_daemon_set" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    labels {
+      TestLabelOne = "one"
+    }
+    name = "%s"
+  }
+  spec {
+    update_strategy {
+      type = "RollingUpdate"
+    }
+    template {
+      metadata {
+        labels {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image = "%s"
+          name  = "tf-acc-test"
+        }
+      }
+    }
+  }
+}
+`, name, imageName)
+}
+
+func testAccKubernetesDaemonSetConfig_generatedName(prefix string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_daemon_set" "test" {
+  metadata {
+    generate_name = "%s"
+  }
+  spec {
+    template {
+      metadata {
+        labels {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image = "nginx:1.7.9"
+          name  = "tf-acc-test"
+        }
+      }
+    }
+  }
+}`, prefix)
+}
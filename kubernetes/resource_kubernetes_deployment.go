@@ -3,7 +3,9 @@ package kubernetes
 import (
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,7 +22,13 @@ func resourceKubernetesDeployment() *schema.Resource {
 		Update: resourceKubernetesDeploymentUpdate,
 		Delete: resourceKubernetesDeploymentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importNamespacedState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -36,13 +44,14 @@ func resourceKubernetesDeployment() *schema.Resource {
 							Type:        schema.TypeInt,
 							Description: "Minimum number of seconds for which a newly created pod should be ready without any of its container crashing, for it to be considered available",
 							Optional:    true,
+							Default:     0,
 						},
 						"paused": {
 							Type:        schema.TypeBool,
 							Description: "Indicates that the deployment is paused.",
 							Optional:    true,
 						},
-						"progress_deadline_seconds ": {
+						"progress_deadline_seconds": {
 							Type:        schema.TypeInt,
 							Description: "The maximum time in seconds for a deployment to make progress before it is considered to be failed. The deployment controller will continue to process failed deployments and a condition with a ProgressDeadlineExceeded reason will be surfaced in the deployment status. Once autoRollback is implemented, the deployment controller will automatically rollback failed deployments. Note that progress will not be estimated during the time a deployment is paused",
 							Optional:    true,
@@ -73,33 +82,40 @@ func resourceKubernetesDeployment() *schema.Resource {
 							Type:        schema.TypeList,
 							Description: "Label selector for pods. Existing ReplicaSets whose pods are selected by this will be the ones affected by this deployment.",
 							Optional:    true,
+							Computed:    true,
 							ForceNew:    true,
 							MaxItems:    1,
 							Elem: &schema.Resource{
-								Schema: generateLabelSelector(),
+								Schema: generateLabelSelector(false),
 							},
 						},
 						"strategy": {
 							Type:        schema.TypeList,
 							Description: "The deployment strategy to use to replace existing pods with new ones.",
 							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"rolling_update": {
 										Type:        schema.TypeList,
 										Description: "Rolling update config params. Present only if DeploymentStrategyType = RollingUpdate.",
 										Optional:    true,
+										Computed:    true,
+										MaxItems:    1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"max_surge": {
 													Type:        schema.TypeString,
 													Description: "The maximum number of pods that can be scheduled above the desired number of pods. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). This can not be 0 if MaxUnavailable is 0. Absolute number is calculated from percentage by rounding up. By default, a value of 1 is used. Example: when this is set to 30%, the new RC can be scaled up immediately when the rolling update starts, such that the total number of old and new pods do not exceed 130% of desired pods. Once old pods have been killed, new RC can be scaled up further, ensuring that total number of pods running at any time during the update is atmost 130% of desired pods.",
 													Optional:    true,
+													Computed:    true,
 												},
 												"max_unavailable": {
 													Type:        schema.TypeString,
 													Description: "The maximum number of pods that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). Absolute number is calculated from percentage by rounding down. This can not be 0 if MaxSurge is 0. By default, a fixed value of 1 is used. Example: when this is set to 30%, the old RC can be scaled down to 70% of desired pods immediately when the rolling update starts. Once new pods are ready, old RC can be scaled down further, followed by scaling up the new RC, ensuring that the total number of pods available at all times during the update is at least 70% of desired pods.",
 													Optional:    true,
+													Computed:    true,
 												},
 											},
 										},
@@ -116,20 +132,10 @@ func resourceKubernetesDeployment() *schema.Resource {
 						"template": {
 							Type:        schema.TypeList,
 							Description: "Template describes the pods that will be created.",
-							Optional:    true,
+							Required:    true,
+							MaxItems:    1,
 							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"metadata": namespacedMetadataSchema("template", true),
-									"spec": {
-										Type:        schema.TypeList,
-										Description: "Specification of the desired behavior of the pod.",
-										Optional:    true,
-										MaxItems:    1,
-										Elem: &schema.Resource{
-											Schema: podSpecFields(false),
-										},
-									},
-								},
+								Schema: podTemplateFields(true),
 							},
 						},
 					},
@@ -138,45 +144,45 @@ func resourceKubernetesDeployment() *schema.Resource {
 			"status": {
 				Type:        schema.TypeList,
 				Description: "Most recently observed status of the Deployment.",
-				Optional:    true,
+				Computed:    true,
 				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"available_replicas": {
 							Type:        schema.TypeInt,
 							Description: "Total number of available pods (ready for at least minReadySeconds) targeted by this deployment.",
-							Optional:    true,
+							Computed:    true,
 						},
 						"conditions": {
 							Type:        schema.TypeList,
 							Description: "Represents the latest available observations of a deployment's current state.",
-							Optional:    true,
+							Computed:    true,
 							Elem:        generateDeploymentCondition(),
 						},
 						"observed_generation": {
 							Type:        schema.TypeInt,
 							Description: "The generation observed by the deployment controller",
-							Optional:    true,
+							Computed:    true,
 						},
 						"ready_replicas": {
 							Type:        schema.TypeInt,
 							Description: "Total number of ready pods targeted by this deployment.",
-							Optional:    true,
+							Computed:    true,
 						},
 						"replicas": {
 							Type:        schema.TypeInt,
 							Description: "Total number of non-terminated pods targeted by this deployment (their labels match the selector).",
-							Optional:    true,
+							Computed:    true,
 						},
 						"unavailable_replicas": {
 							Type:        schema.TypeInt,
 							Description: "Total number of unavailable pods targeted by this deployment.",
-							Optional:    true,
+							Computed:    true,
 						},
 						"updated_replicas": {
 							Type:        schema.TypeInt,
 							Description: "Total number of non-terminated pods targeted by this deployment that have the desired template spec.",
-							Optional:    true,
+							Computed:    true,
 						},
 					},
 				},
@@ -186,53 +192,76 @@ func resourceKubernetesDeployment() *schema.Resource {
 }
 
 func resourceKubernetesDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
+
+	metadata := expandNamespacedMetadata(d, meta)
+	spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return err
+	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	svc := ex_v1beta1.Deployment{
+	spec.Template.Spec.AutomountServiceAccountToken = ptrToBool(false)
+
+	deployment := ex_v1beta1.Deployment{
 		ObjectMeta: metadata,
-		Spec:       expandDeploymentSpec(d.Get("spec").([]interface{})),
-		Status:     expandDeploymentStatus(d.Get("status").([]interface{})),
+		Spec:       spec,
 	}
-	log.Printf("[INFO] Creating new deployment: %#v", svc)
-	out, err := conn.ExtensionsV1beta1().Deployments(metadata.Namespace).Create(&svc)
+
+	log.Printf("[INFO] Creating new deployment: %#v", deployment)
+	out, err := conn.ExtensionsV1beta1().Deployments(metadata.Namespace).Create(&deployment)
+	if err != nil {
+		return fmt.Errorf("Failed to create deployment: %s", err)
+	}
+
+	d.SetId(buildId(out.ObjectMeta))
+
+	log.Printf("[DEBUG] Waiting for deployment %s to roll out", d.Id())
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate),
+		waitForDeploymentReplicasFunc(conn, out.GetNamespace(), out.GetName()))
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[INFO] Submitted new deployment: %#v", out)
-	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesDeploymentRead(d, meta)
 }
 
 func resourceKubernetesDeploymentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 	log.Printf("[INFO] Reading deployment %s", name)
-	svc, err := conn.ExtensionsV1beta1().Deployments(namespace).Get(name, v1.GetOptions{})
+	deployment, err := conn.ExtensionsV1beta1().Deployments(namespace).Get(name, v1.GetOptions{})
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
 		return err
 	}
-	log.Printf("[INFO] Received deployment: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta))
+	log.Printf("[INFO] Received deployment: %#v", deployment)
+	err = d.Set("metadata", flattenMetadata(deployment.ObjectMeta, d))
 	if err != nil {
 		return err
 	}
 
-	flattenedSpec := flattenDeploymentSpec(svc.Spec)
+	flattenedSpec, err := flattenDeploymentSpec(deployment.Spec)
+	if err != nil {
+		return err
+	}
+	// rollbackTo is cleared by the server once the rollback is done,
+	// so we keep whatever was last requested in the configuration.
+	if v, ok := d.GetOk("spec.0.rollback_to"); ok {
+		flattenedSpec[0].(map[string]interface{})["rollback_to"] = v
+	}
 	log.Printf("[DEBUG] Flattened deployment spec: %#v", flattenedSpec)
 	err = d.Set("spec", flattenedSpec)
 	if err != nil {
 		return err
 	}
 
-	flattenedStatus := flattenDeploymentStatus(svc.Status)
+	flattenedStatus := flattenDeploymentStatus(deployment.Status)
 	log.Printf("[DEBUG] Flattened deployment status: %#v", flattenedStatus)
 	err = d.Set("status", flattenedStatus)
 	if err != nil {
@@ -243,42 +272,88 @@ func resourceKubernetesDeploymentRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	if d.HasChange("spec") {
-		diffOps := patchDeploymentSpec("spec.0.", "/spec", d)
-		ops = append(ops, diffOps...)
+	var out *ex_v1beta1.Deployment
+	patch := func(pt types.PatchType, data []byte) (err error) {
+		log.Printf("[INFO] Updating deployment %q: %v", name, string(data))
+		out, err = conn.ExtensionsV1beta1().Deployments(namespace).Patch(name, pt, data)
+		return err
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
+
+	if meta.(*kubeProvider).strategicMergePatch {
+		oldDeployment, newDeployment, err := expandDeploymentChange(d)
+		if err != nil {
+			return err
+		}
+		err = strategicPatchResource(d, meta, oldDeployment, newDeployment, resourceKubernetesDeploymentRead, func(data []byte) error {
+			return patch(types.StrategicMergePatchType, data)
+		})
+	} else {
+		err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+			ops := patchMetadata("metadata.0.", "/metadata/", d)
+			if d.HasChange("spec") {
+				diffOps, err := patchDeploymentSpec("spec.0.", "/spec", d)
+				if err != nil {
+					return nil, err
+				}
+				ops = append(ops, diffOps...)
+			}
+			return ops, nil
+		}, resourceKubernetesDeploymentRead, func(data []byte) error {
+			return patch(types.JSONPatchType, data)
+		})
 	}
-	log.Printf("[INFO] Updating deployment %q: %v", name, string(data))
-	out, err := conn.ExtensionsV1beta1().Deployments(namespace).Patch(name, types.JSONPatchType, data)
 	if err != nil {
 		return fmt.Errorf("Failed to update deployment: %s", err)
 	}
 	log.Printf("[INFO] Submitted updated deployment: %#v", out)
-	d.SetId(buildId(out.ObjectMeta))
 
-	return resourceKubernetesHorizontalPodAutoscalerRead(d, meta)
+	err = resource.Retry(d.Timeout(schema.TimeoutUpdate),
+		waitForDeploymentReplicasFunc(conn, namespace, name))
+	if err != nil {
+		return err
+	}
+
+	return resourceKubernetesDeploymentRead(d, meta)
 }
 
 func resourceKubernetesDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 	log.Printf("[INFO] Deleting deployment: %#v", name)
-	err = conn.ExtensionsV1beta1().Deployments(namespace).Delete(name, &v1.DeleteOptions{})
+
+	// Deployments in extensions/v1beta1 orphan their replica sets by default
+	propagation := v1.DeletePropagationForeground
+	err = conn.ExtensionsV1beta1().Deployments(namespace).Delete(name, &v1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Wait until the deployment and its pods are gone
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.ExtensionsV1beta1().Deployments(namespace).Get(name, v1.GetOptions{})
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("Deployment %s still exists", name)
+		return resource.RetryableError(e)
+	})
 	if err != nil {
 		return err
 	}
@@ -290,7 +365,7 @@ func resourceKubernetesDeploymentDelete(d *schema.ResourceData, meta interface{}
 }
 
 func resourceKubernetesDeploymentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -308,6 +383,61 @@ func resourceKubernetesDeploymentExists(d *schema.ResourceData, meta interface{}
 	return true, err
 }
 
+// waitForDeploymentReplicasFunc mirrors the logic of `kubectl rollout status`:
+// the rollout is complete once the controller has observed the latest
+// generation and all desired replicas are updated and available.
+func waitForDeploymentReplicasFunc(conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
+	return func() *resource.RetryError {
+		deployment, err := conn.ExtensionsV1beta1().Deployments(ns).Get(name, v1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if deployment.Generation > deployment.Status.ObservedGeneration {
+			return resource.RetryableError(fmt.Errorf("Waiting for rollout of deployment %q to start", name))
+		}
+
+		for _, c := range deployment.Status.Conditions {
+			if c.Type == ex_v1beta1.DeploymentProgressing && c.Reason == "ProgressDeadlineExceeded" {
+				err := fmt.Errorf("Deployment %q exceeded its progress deadline: %s", name, c.Message)
+				lastWarnings, wErr := getLastWarningsForObject(conn, deployment.ObjectMeta, "Deployment", 3)
+				if wErr == nil {
+					err = fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+				}
+				return resource.NonRetryableError(err)
+			}
+		}
+
+		if deployment.Spec.Paused {
+			log.Printf("[DEBUG] Deployment %q is paused, not waiting for rollout", name)
+			return nil
+		}
+
+		desiredReplicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desiredReplicas = *deployment.Spec.Replicas
+		}
+		status := deployment.Status
+		log.Printf("[DEBUG] Current number of updated replicas of %q: %d, available: %d (of %d)\n",
+			name, status.UpdatedReplicas, status.AvailableReplicas, desiredReplicas)
+
+		if status.UpdatedReplicas < desiredReplicas {
+			return resource.RetryableError(fmt.Errorf("Waiting for rollout of %q to finish: %d out of %d new replicas have been updated",
+				name, status.UpdatedReplicas, desiredReplicas))
+		}
+		if status.Replicas > status.UpdatedReplicas {
+			return resource.RetryableError(fmt.Errorf("Waiting for rollout of %q to finish: %d old replicas are pending termination",
+				name, status.Replicas-status.UpdatedReplicas))
+		}
+		if status.AvailableReplicas < status.UpdatedReplicas {
+			return resource.RetryableError(fmt.Errorf("Waiting for rollout of %q to finish: %d of %d updated replicas are available",
+				name, status.AvailableReplicas, status.UpdatedReplicas))
+		}
+
+		return nil
+	}
+}
+
 func generateRollbackTo() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"revision": {
@@ -318,32 +448,32 @@ func generateRollbackTo() map[string]*schema.Schema {
 	}
 }
 
-func generateLabelSelector() map[string]*schema.Schema {
+func generateLabelSelector(isUpdatable bool) map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"match_expressions": {
 			Type:        schema.TypeList,
 			Description: "A list of label selector requirements. The requirements are ANDed.",
 			Optional:    true,
-			ForceNew:    true,
+			ForceNew:    !isUpdatable,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"key": {
 						Type:        schema.TypeString,
 						Description: "The label key that the selector applies to.",
 						Optional:    true,
-						ForceNew:    true,
+						ForceNew:    !isUpdatable,
 					},
 					"operator": {
 						Type:        schema.TypeString,
 						Description: "A key's relationship to a set of values. Valid operators ard `In`, `NotIn`, `Exists` and `DoesNotExist`.",
 						Optional:    true,
-						ForceNew:    true,
+						ForceNew:    !isUpdatable,
 					},
 					"values": {
 						Type:        schema.TypeSet,
 						Description: "An array of string values. If the operator is `In` or `NotIn`, the values array must be non-empty. If the operator is `Exists` or `DoesNotExist`, the values array must be empty. This array is replaced during a strategic merge patch.",
 						Optional:    true,
-						ForceNew:    true,
+						ForceNew:    !isUpdatable,
 						Elem:        &schema.Schema{Type: schema.TypeString},
 						Set:         schema.HashString,
 					},
@@ -354,7 +484,7 @@ func generateLabelSelector() map[string]*schema.Schema {
 			Type:        schema.TypeMap,
 			Description: "A map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of `match_expressions`, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
 			Optional:    true,
-			ForceNew:    true,
+			ForceNew:    !isUpdatable,
 		},
 	}
 }
@@ -365,23 +495,46 @@ func generateDeploymentCondition() *schema.Resource {
 			"message": {
 				Type:        schema.TypeString,
 				Description: "A human readable message indicating details about the transition.",
-				Optional:    true,
+				Computed:    true,
 			},
 			"reason": {
 				Type:        schema.TypeString,
 				Description: "The reason for the condition's last transition.",
-				Optional:    true,
+				Computed:    true,
 			},
 			"status": {
 				Type:        schema.TypeString,
 				Description: "Status of the condition, one of True, False, Unknown.",
-				Optional:    true,
+				Computed:    true,
 			},
 			"type": {
 				Type:        schema.TypeString,
 				Description: "Type of deployment condition.",
-				Optional:    true,
+				Computed:    true,
 			},
 		},
 	}
 }
+
+// expandDeploymentChange returns the deployment as it was last applied and
+// as it's now configured
+func expandDeploymentChange(d *schema.ResourceData) (*ex_v1beta1.Deployment, *ex_v1beta1.Deployment, error) {
+	oldMeta, newMeta := d.GetChange("metadata")
+	oldSpec, newSpec := d.GetChange("spec")
+
+	oldDeployment := &ex_v1beta1.Deployment{ObjectMeta: expandMetadata(oldMeta.([]interface{}))}
+	spec, err := expandDeploymentSpec(oldSpec.([]interface{}))
+	if err != nil {
+		return nil, nil, err
+	}
+	oldDeployment.Spec = spec
+
+	newDeployment := &ex_v1beta1.Deployment{ObjectMeta: expandMetadata(newMeta.([]interface{}))}
+	spec, err = expandDeploymentSpec(newSpec.([]interface{}))
+	if err != nil {
+		return nil, nil, err
+	}
+	newDeployment.Spec = spec
+
+	return oldDeployment, newDeployment, nil
+}
@@ -0,0 +1,145 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	api "k8s.io/kubernetes/pkg/api/v1"
+)
+
+func resourceKubernetesEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesEndpointsCreate,
+		Read:   resourceKubernetesEndpointsRead,
+		Exists: resourceKubernetesEndpointsExists,
+		Update: resourceKubernetesEndpointsUpdate,
+		Delete: resourceKubernetesEndpointsDelete,
+		Importer: &schema.ResourceImporter{
+			State: importNamespacedState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("endpoints", false),
+			"subset":   endpointsSubsetSchema(),
+		},
+	}
+}
+
+func resourceKubernetesEndpointsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	metadata := expandNamespacedMetadata(d, meta)
+	ep := api.Endpoints{
+		ObjectMeta: metadata,
+		Subsets:    expandEndpointsSubsets(d.Get("subset").(*schema.Set).List()),
+	}
+	log.Printf("[INFO] Creating new endpoints: %#v", ep)
+	out, err := conn.CoreV1().Endpoints(metadata.Namespace).Create(&ep)
+	if err != nil {
+		return fmt.Errorf("Failed to create endpoints: %s", err)
+	}
+	log.Printf("[INFO] Submitted new endpoints: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesEndpointsRead(d, meta)
+}
+
+func resourceKubernetesEndpointsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading endpoints %s", name)
+	ep, err := conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received endpoints: %#v", ep)
+	err = d.Set("metadata", flattenMetadata(ep.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	flattened := flattenEndpointsSubsets(ep.Subsets)
+	log.Printf("[DEBUG] Flattened endpoints subset: %#v", flattened)
+	err = d.Set("subset", flattened)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesEndpointsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var out *api.Endpoints
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		ops = append(ops, patchEndpointsSubsets("", "/", d)...)
+		return ops, nil
+	}, resourceKubernetesEndpointsRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating endpoints %q: %v", name, string(data))
+		out, err = conn.CoreV1().Endpoints(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update endpoints: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated endpoints: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesEndpointsRead(d, meta)
+}
+
+func resourceKubernetesEndpointsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting endpoints: %#v", name)
+	err = conn.CoreV1().Endpoints(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Endpoints %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesEndpointsExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking endpoints %s", name)
+	_, err = conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
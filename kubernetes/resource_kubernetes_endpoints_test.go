@@ -0,0 +1,224 @@
+package kubernetes
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/kubernetes/pkg/api/v1"
+)
+
+func TestAccKubernetesEndpoints_basic(t *testing.T) {
+	var conf api.Endpoints
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_endpoints.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesEndpointsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesEndpointsConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesEndpointsExists("kubernetes_endpoints.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.labels.%", "0"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_endpoints.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_endpoints.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_endpoints.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_endpoints.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.#", "1"),
+					testAccCheckKubernetesEndpointsSubsets(&conf, []api.EndpointSubset{
+						{
+							Addresses: []api.EndpointAddress{{IP: "10.0.0.4"}},
+							Ports:     []api.EndpointPort{{Name: "http", Port: 80, Protocol: api.ProtocolTCP}},
+						},
+					}),
+				),
+			},
+			{
+				Config: testAccKubernetesEndpointsConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesEndpointsExists("kubernetes_endpoints.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.annotations.%", "0"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{}),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.labels.TestLabelOne", "one"),
+					testAccCheckMetaLabels(&conf.ObjectMeta, map[string]string{"TestLabelOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.#", "2"),
+				),
+			},
+			{
+				Config: testAccKubernetesEndpointsConfig_empty(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesEndpointsExists("kubernetes_endpoints.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesEndpoints_importBasic(t *testing.T) {
+	resourceName := "kubernetes_endpoints.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesEndpointsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesEndpointsConfig_modified(name),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesEndpointsSubsets(ep *api.Endpoints, expected []api.EndpointSubset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(expected) == 0 && len(ep.Subsets) == 0 {
+			return nil
+		}
+		if !reflect.DeepEqual(ep.Subsets, expected) {
+			return fmt.Errorf("Endpoints subsets don't match.\nExpected: %#v\nGiven: %#v", expected, ep.Subsets)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesEndpointsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubeProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_endpoints" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Endpoints still exist: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesEndpointsExists(n string, obj *api.Endpoints) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubeProvider).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesEndpointsConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_endpoints" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    name = "%s"
+  }
+  subset {
+    address {
+      ip = "10.0.0.4"
+    }
+    port {
+      name = "http"
+      port = 80
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesEndpointsConfig_modified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_endpoints" "test" {
+  metadata {
+    labels {
+      TestLabelOne = "one"
+    }
+    name = "%s"
+  }
+  subset {
+    address {
+      ip = "10.0.0.5"
+    }
+    address {
+      ip = "10.0.0.4"
+    }
+    not_ready_address {
+      ip       = "10.0.0.6"
+      hostname = "web-2"
+    }
+    port {
+      name = "http"
+      port = 80
+    }
+  }
+  subset {
+    address {
+      ip = "10.0.1.4"
+    }
+    port {
+      name     = "dns"
+      port     = 53
+      protocol = "UDP"
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesEndpointsConfig_empty(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_endpoints" "test" {
+  metadata {
+    labels {
+      TestLabelOne = "one"
+    }
+    name = "%s"
+  }
+}
+`, name)
+}
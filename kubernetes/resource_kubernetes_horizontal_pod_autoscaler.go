@@ -9,7 +9,6 @@ import (
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	api "k8s.io/kubernetes/pkg/apis/autoscaling/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 func resourceKubernetesHorizontalPodAutoscaler() *schema.Resource {
@@ -20,7 +19,7 @@ func resourceKubernetesHorizontalPodAutoscaler() *schema.Resource {
 		Update: resourceKubernetesHorizontalPodAutoscalerUpdate,
 		Delete: resourceKubernetesHorizontalPodAutoscalerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importNamespacedState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,9 +81,9 @@ func resourceKubernetesHorizontalPodAutoscaler() *schema.Resource {
 }
 
 func resourceKubernetesHorizontalPodAutoscalerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandNamespacedMetadata(d, meta)
 	svc := api.HorizontalPodAutoscaler{
 		ObjectMeta: metadata,
 		Spec:       expandHorizontalPodAutoscalerSpec(d.Get("spec").([]interface{})),
@@ -102,7 +101,7 @@ func resourceKubernetesHorizontalPodAutoscalerCreate(d *schema.ResourceData, met
 }
 
 func resourceKubernetesHorizontalPodAutoscalerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -115,7 +114,7 @@ func resourceKubernetesHorizontalPodAutoscalerRead(d *schema.ResourceData, meta
 		return err
 	}
 	log.Printf("[INFO] Received horizontal pod autoscaler: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta))
+	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d))
 	if err != nil {
 		return err
 	}
@@ -131,24 +130,29 @@ func resourceKubernetesHorizontalPodAutoscalerRead(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesHorizontalPodAutoscalerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	if d.HasChange("spec") {
-		diffOps := patchHorizontalPodAutoscalerSpec("spec.0.", "/spec", d)
-		ops = append(ops, diffOps...)
-	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating horizontal pod autoscaler %q: %v", name, string(data))
-	out, err := conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	var out *api.HorizontalPodAutoscaler
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		if d.HasChange("spec") {
+			diffOps, err := patchHorizontalPodAutoscalerSpec("spec.0.", "/spec", d)
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, diffOps...)
+		}
+		return ops, nil
+	}, resourceKubernetesHorizontalPodAutoscalerRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating horizontal pod autoscaler %q: %v", name, string(data))
+		out, err = conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update horizontal pod autoscaler: %s", err)
 	}
@@ -159,7 +163,7 @@ func resourceKubernetesHorizontalPodAutoscalerUpdate(d *schema.ResourceData, met
 }
 
 func resourceKubernetesHorizontalPodAutoscalerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -178,7 +182,7 @@ func resourceKubernetesHorizontalPodAutoscalerDelete(d *schema.ResourceData, met
 }
 
 func resourceKubernetesHorizontalPodAutoscalerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -0,0 +1,224 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	ex_v1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+func resourceKubernetesIngress() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesIngressCreate,
+		Read:   resourceKubernetesIngressRead,
+		Exists: resourceKubernetesIngressExists,
+		Update: resourceKubernetesIngressUpdate,
+		Delete: resourceKubernetesIngressDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_load_balancer", false)
+				return importNamespacedState(d, meta)
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("ingress", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the behavior of an ingress. https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: ingressSpecFields(),
+				},
+			},
+			"wait_for_load_balancer": {
+				Type:        schema.TypeBool,
+				Description: "Whether to wait for the ingress controller to assign an IP/hostname to the ingress when it is created.",
+				Optional:    true,
+				Default:     false,
+			},
+			"load_balancer_ingress": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesIngressCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	metadata := expandNamespacedMetadata(d, meta)
+	ing := ex_v1beta1.Ingress{
+		ObjectMeta: metadata,
+		Spec:       expandIngressSpec(d.Get("spec").([]interface{})),
+	}
+	log.Printf("[INFO] Creating new ingress: %#v", ing)
+	out, err := conn.ExtensionsV1beta1().Ingresses(metadata.Namespace).Create(&ing)
+	if err != nil {
+		return fmt.Errorf("Failed to create ingress: %s", err)
+	}
+	log.Printf("[INFO] Submitted new ingress: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	if d.Get("wait_for_load_balancer").(bool) {
+		log.Printf("[DEBUG] Waiting for load balancer to assign IP/hostname")
+
+		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			ing, err := conn.ExtensionsV1beta1().Ingresses(out.Namespace).Get(out.Name, metav1.GetOptions{})
+			if err != nil {
+				log.Printf("[DEBUG] Received error: %#v", err)
+				return resource.NonRetryableError(err)
+			}
+
+			lbIngress := ing.Status.LoadBalancer.Ingress
+
+			log.Printf("[INFO] Received ingress status: %#v", ing.Status)
+			if len(lbIngress) > 0 {
+				return nil
+			}
+
+			return resource.RetryableError(fmt.Errorf(
+				"Waiting for ingress %q to assign IP/hostname for a load balancer", d.Id()))
+		})
+		if err != nil {
+			lastWarnings, wErr := getLastWarningsForObject(conn, out.ObjectMeta, "Ingress", 3)
+			if wErr == nil {
+				err = fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+			}
+			return err
+		}
+	}
+
+	return resourceKubernetesIngressRead(d, meta)
+}
+
+func resourceKubernetesIngressRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading ingress %s", name)
+	ing, err := conn.ExtensionsV1beta1().Ingresses(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received ingress: %#v", ing)
+	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("load_balancer_ingress", flattenLoadBalancerIngress(ing.Status.LoadBalancer.Ingress))
+	if err != nil {
+		return err
+	}
+
+	flattened := flattenIngressSpec(ing.Spec)
+	log.Printf("[DEBUG] Flattened ingress spec: %#v", flattened)
+	err = d.Set("spec", flattened)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesIngressUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var out *ex_v1beta1.Ingress
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		if d.HasChange("spec") {
+			diffOps, err := patchIngressSpec("spec.0.", "/spec/", d)
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, diffOps...)
+		}
+		return ops, nil
+	}, resourceKubernetesIngressRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating ingress %q: %v", name, string(data))
+		out, err = conn.ExtensionsV1beta1().Ingresses(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update ingress: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated ingress: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesIngressRead(d, meta)
+}
+
+func resourceKubernetesIngressDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting ingress: %#v", name)
+	err = conn.ExtensionsV1beta1().Ingresses(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Ingress %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesIngressExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking ingress %s", name)
+	_, err = conn.ExtensionsV1beta1().Ingresses(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
@@ -0,0 +1,203 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ex_v1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+func TestAccKubernetesIngress_basic(t *testing.T) {
+	var conf ex_v1beta1.Ingress
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_ingress.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesIngressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesIngressConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressExists("kubernetes_ingress.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_ingress.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_ingress.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_ingress.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_ingress.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.backend.0.service_name", "app1"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.backend.0.service_port", "443"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.host", "server.domain.com"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.path", "/.*"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service_name", "app2"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.0.http.0.path.0.backend.0.service_port", "http"),
+				),
+			},
+			{
+				Config: testAccKubernetesIngressConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressExists("kubernetes_ingress.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.backend.#", "0"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.rule.1.host", "other.domain.com"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.tls.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.tls.0.hosts.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "spec.0.tls.0.secret_name", "super-sekret"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesIngress_importBasic(t *testing.T) {
+	resourceName := "kubernetes_ingress.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesIngressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesIngressConfig_basic(name),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesIngressDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubeProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_ingress" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.ExtensionsV1beta1().Ingresses(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Ingress still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesIngressExists(n string, obj *ex_v1beta1.Ingress) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubeProvider).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.ExtensionsV1beta1().Ingresses(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesIngressConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_ingress" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    name = "%s"
+  }
+  spec {
+    backend {
+      service_name = "app1"
+      service_port = 443
+    }
+    rule {
+      host = "server.domain.com"
+      http {
+        path {
+          backend {
+            service_name = "app2"
+            service_port = "http"
+          }
+          path = "/.*"
+        }
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesIngressConfig_modified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_ingress" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    name = "%s"
+  }
+  spec {
+    rule {
+      host = "server.domain.com"
+      http {
+        path {
+          backend {
+            service_name = "app2"
+            service_port = "http"
+          }
+          path = "/.*"
+        }
+      }
+    }
+    rule {
+      host = "other.domain.com"
+      http {
+        path {
+          backend {
+            service_name = "app3"
+            service_port = 8080
+          }
+        }
+      }
+    }
+    tls {
+      hosts       = ["server.domain.com", "other.domain.com"]
+      secret_name = "super-sekret"
+    }
+  }
+}
+`, name)
+}
@@ -0,0 +1,277 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	api "k8s.io/kubernetes/pkg/api/v1"
+	batch_v1 "k8s.io/kubernetes/pkg/apis/batch/v1"
+	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+)
+
+func resourceKubernetesJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesJobCreate,
+		Read:   resourceKubernetesJobRead,
+		Exists: resourceKubernetesJobExists,
+		Update: resourceKubernetesJobUpdate,
+		Delete: resourceKubernetesJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_completion", true)
+				return importNamespacedState(d, meta)
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("job", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec of the job owned by the cluster. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: jobSpecFields(false),
+				},
+			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Description: "Whether to wait for the job to complete successfully when it is created.",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	metadata := expandNamespacedMetadata(d, meta)
+	spec, err := expandJobSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	spec.Template.Spec.AutomountServiceAccountToken = ptrToBool(false)
+
+	job := batch_v1.Job{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}
+
+	log.Printf("[INFO] Creating new job: %#v", job)
+	out, err := conn.BatchV1().Jobs(metadata.Namespace).Create(&job)
+	if err != nil {
+		return fmt.Errorf("Failed to create job: %s", err)
+	}
+	log.Printf("[INFO] Submitted new job: %#v", out)
+
+	d.SetId(buildId(out.ObjectMeta))
+
+	if d.Get("wait_for_completion").(bool) {
+		log.Printf("[DEBUG] Waiting for job %s to complete", d.Id())
+		err = resource.Retry(d.Timeout(schema.TimeoutCreate),
+			waitForJobCompletionFunc(conn, out.GetNamespace(), out.GetName()))
+		if err != nil {
+			lastWarnings, wErr := getLastWarningsForJob(conn, out)
+			if wErr == nil {
+				err = fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+			}
+			return err
+		}
+		log.Printf("[INFO] Job %s completed", out.Name)
+	}
+
+	return resourceKubernetesJobRead(d, meta)
+}
+
+func resourceKubernetesJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading job %s", name)
+	job, err := conn.BatchV1().Jobs(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received job: %#v", job)
+
+	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	spec, err := flattenJobSpec(job.Spec)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("spec", spec)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var out *batch_v1.Job
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		if d.HasChange("spec") {
+			diffOps := patchJobSpec("spec.0.", "/spec", d)
+			ops = append(ops, diffOps...)
+		}
+		return ops, nil
+	}, resourceKubernetesJobRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating job %q: %v", name, string(data))
+		out, err = conn.BatchV1().Jobs(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update job: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated job: %#v", out)
+
+	return resourceKubernetesJobRead(d, meta)
+}
+
+func resourceKubernetesJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting job: %#v", name)
+
+	// Jobs in batch/v1 orphan their pods by default
+	propagation := metav1.DeletePropagationForeground
+	err = conn.BatchV1().Jobs(namespace).Delete(name, &metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Wait until the job and its pods are gone
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.BatchV1().Jobs(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("Job %s still exists", name)
+		return resource.RetryableError(e)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Job %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesJobExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking job %s", name)
+	_, err = conn.BatchV1().Jobs(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+func waitForJobCompletionFunc(conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
+	return func() *resource.RetryError {
+		job, err := conn.BatchV1().Jobs(ns).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		for _, c := range job.Status.Conditions {
+			if c.Status != api.ConditionTrue {
+				continue
+			}
+			switch c.Type {
+			case batch_v1.JobComplete:
+				return nil
+			case batch_v1.JobFailed:
+				return resource.NonRetryableError(fmt.Errorf("Job %q failed: %s: %s", name, c.Reason, c.Message))
+			}
+		}
+
+		log.Printf("[DEBUG] Current status of job %q: %d active, %d succeeded, %d failed\n",
+			name, job.Status.Active, job.Status.Succeeded, job.Status.Failed)
+
+		return resource.RetryableError(fmt.Errorf("Waiting for job %q to complete (%d succeeded, %d failed)",
+			name, job.Status.Succeeded, job.Status.Failed))
+	}
+}
+
+// getLastWarningsForJob collects the last warnings of the job
+// as well as of each of its pods.
+func getLastWarningsForJob(conn *kubernetes.Clientset, job *batch_v1.Job) ([]api.Event, error) {
+	warnings, err := getLastWarningsForObject(conn, job.ObjectMeta, "Job", 3)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Spec.Selector == nil {
+		return warnings, nil
+	}
+	pods, err := conn.CoreV1().Pods(job.Namespace).List(metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(job.Spec.Selector),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		podWarnings, err := getLastWarningsForObject(conn, pod.ObjectMeta, "Pod", 3)
+		if err != nil {
+			return nil, err
+		}
+		warnings = append(warnings, podWarnings...)
+	}
+
+	return warnings, nil
+}
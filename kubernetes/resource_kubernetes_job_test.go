@@ -0,0 +1,209 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	batch_v1 "k8s.io/kubernetes/pkg/apis/batch/v1"
+)
+
+func TestAccKubernetesJob_basic(t *testing.T) {
+	var conf batch_v1.Job
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_job.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesJobConfig_basic(name, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobExists("kubernetes_job.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_job.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_job.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_job.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_job.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.completions", "2"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.parallelism", "1"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.template.0.metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.template.0.spec.0.container.0.image", "busybox"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.template.0.spec.0.restart_policy", "Never"),
+					testAccCheckKubernetesJobSucceeded(&conf, 2),
+				),
+			},
+			{
+				Config: testAccKubernetesJobConfig_basic(name, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobExists("kubernetes_job.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "spec.0.parallelism", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesJob_importBasic(t *testing.T) {
+	resourceName := "kubernetes_job.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesJobConfig_basic(name, 1),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKubernetesJob_failed(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesJobConfig_failing(name),
+				ExpectError: regexp.MustCompile("DeadlineExceeded"),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubeProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_job" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.BatchV1().Jobs(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Job still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesJobExists(n string, obj *batch_v1.Job) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubeProvider).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.BatchV1().Jobs(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccCheckKubernetesJobSucceeded(obj *batch_v1.Job, expected int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if obj.Status.Succeeded != expected {
+			return fmt.Errorf("Expected %d succeeded pods, given %d", expected, obj.Status.Succeeded)
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesJobConfig_basic(name string, parallelism int) string {
+	return fmt.Sprintf(`
+resource "kubernetes_job" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    name = "%s"
+  }
+  spec {
+    completions = 2
+    parallelism = %d
+    template {
+      metadata {
+        labels {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          command = ["sh", "-c", "echo done"]
+          image   = "busybox"
+          name    = "tf-acc-test"
+        }
+        restart_policy = "Never"
+      }
+    }
+  }
+}
+`, name, parallelism)
+}
+
+func testAccKubernetesJobConfig_failing(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_job" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    active_deadline_seconds = 20
+    template {
+      metadata {
+        labels {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          command = ["sh", "-c", "exit 1"]
+          image   = "busybox"
+          name    = "tf-acc-test"
+        }
+        restart_policy = "Never"
+      }
+    }
+  }
+}
+`, name)
+}
@@ -9,7 +9,6 @@ import (
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	api "k8s.io/kubernetes/pkg/api/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 func resourceKubernetesLimitRange() *schema.Resource {
@@ -20,7 +19,7 @@ func resourceKubernetesLimitRange() *schema.Resource {
 		Update: resourceKubernetesLimitRangeUpdate,
 		Delete: resourceKubernetesLimitRangeDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importNamespacedState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -80,9 +79,9 @@ func resourceKubernetesLimitRange() *schema.Resource {
 }
 
 func resourceKubernetesLimitRangeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandNamespacedMetadata(d, meta)
 	spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
 	if err != nil {
 		return err
@@ -103,7 +102,7 @@ func resourceKubernetesLimitRangeCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceKubernetesLimitRangeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -117,7 +116,7 @@ func resourceKubernetesLimitRangeRead(d *schema.ResourceData, meta interface{})
 	}
 	log.Printf("[INFO] Received limit range: %#v", limitRange)
 
-	err = d.Set("metadata", flattenMetadata(limitRange.ObjectMeta))
+	err = d.Set("metadata", flattenMetadata(limitRange.ObjectMeta, d))
 	if err != nil {
 		return err
 	}
@@ -130,30 +129,33 @@ func resourceKubernetesLimitRangeRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKubernetesLimitRangeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	if d.HasChange("spec") {
-		spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), d.IsNewResource())
-		if err != nil {
-			return err
+	isNew := d.IsNewResource()
+	var out *api.LimitRange
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		if d.HasChange("spec") {
+			spec, err := expandLimitRangeSpec(d.Get("spec").([]interface{}), isNew)
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, &ReplaceOperation{
+				Path:  "/spec",
+				Value: spec,
+			})
 		}
-		ops = append(ops, &ReplaceOperation{
-			Path:  "/spec",
-			Value: spec,
-		})
-	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating limit range %q: %v", name, string(data))
-	out, err := conn.CoreV1().LimitRanges(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return ops, nil
+	}, resourceKubernetesLimitRangeRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating limit range %q: %v", name, string(data))
+		out, err = conn.CoreV1().LimitRanges(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update limit range: %s", err)
 	}
@@ -164,7 +166,7 @@ func resourceKubernetesLimitRangeUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceKubernetesLimitRangeDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -184,7 +186,7 @@ func resourceKubernetesLimitRangeDelete(d *schema.ResourceData, meta interface{}
 }
 
 func resourceKubernetesLimitRangeExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
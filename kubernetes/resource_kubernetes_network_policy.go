@@ -0,0 +1,192 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	ex_v1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+)
+
+// The vendored clientset has no typed client for network policies,
+// so these go through the REST client of the extensions group.
+const networkPolicyResource = "networkpolicies"
+
+func resourceKubernetesNetworkPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesNetworkPolicyCreate,
+		Read:   resourceKubernetesNetworkPolicyRead,
+		Exists: resourceKubernetesNetworkPolicyExists,
+		Update: resourceKubernetesNetworkPolicyUpdate,
+		Delete: resourceKubernetesNetworkPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importNamespacedState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("network policy", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the behavior of a network policy. https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: networkPolicySpecFields(),
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesNetworkPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	metadata := expandNamespacedMetadata(d, meta)
+	np := ex_v1beta1.NetworkPolicy{
+		ObjectMeta: metadata,
+		Spec:       expandNetworkPolicySpec(d.Get("spec").([]interface{})),
+	}
+	log.Printf("[INFO] Creating new network policy: %#v", np)
+	out := &ex_v1beta1.NetworkPolicy{}
+	err := conn.ExtensionsV1beta1().RESTClient().Post().
+		Namespace(metadata.Namespace).
+		Resource(networkPolicyResource).
+		Body(&np).
+		Do().
+		Into(out)
+	if err != nil {
+		return fmt.Errorf("Failed to create network policy: %s", err)
+	}
+	log.Printf("[INFO] Submitted new network policy: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesNetworkPolicyRead(d, meta)
+}
+
+func resourceKubernetesNetworkPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading network policy %s", name)
+	np, err := getNetworkPolicy(conn, namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received network policy: %#v", np)
+	err = d.Set("metadata", flattenMetadata(np.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	flattened := flattenNetworkPolicySpec(np.Spec)
+	log.Printf("[DEBUG] Flattened network policy spec: %#v", flattened)
+	err = d.Set("spec", flattened)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesNetworkPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	out := &ex_v1beta1.NetworkPolicy{}
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		if d.HasChange("spec") {
+			diffOps, err := patchNetworkPolicySpec("spec.0.", "/spec/", d)
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, diffOps...)
+		}
+		return ops, nil
+	}, resourceKubernetesNetworkPolicyRead, func(data []byte) error {
+		log.Printf("[INFO] Updating network policy %q: %v", name, string(data))
+		return conn.ExtensionsV1beta1().RESTClient().Patch(pkgApi.JSONPatchType).
+			Namespace(namespace).
+			Resource(networkPolicyResource).
+			Name(name).
+			Body(data).
+			Do().
+			Into(out)
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update network policy: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated network policy: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesNetworkPolicyRead(d, meta)
+}
+
+func resourceKubernetesNetworkPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting network policy: %#v", name)
+	err = conn.ExtensionsV1beta1().RESTClient().Delete().
+		Namespace(namespace).
+		Resource(networkPolicyResource).
+		Name(name).
+		Body(&metav1.DeleteOptions{}).
+		Do().
+		Error()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Network policy %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesNetworkPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking network policy %s", name)
+	_, err = getNetworkPolicy(conn, namespace, name)
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+func getNetworkPolicy(conn *kubernetes.Clientset, namespace, name string) (*ex_v1beta1.NetworkPolicy, error) {
+	out := &ex_v1beta1.NetworkPolicy{}
+	err := conn.ExtensionsV1beta1().RESTClient().Get().
+		Namespace(namespace).
+		Resource(networkPolicyResource).
+		Name(name).
+		Do().
+		Into(out)
+	return out, err
+}
@@ -11,7 +11,6 @@ import (
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	api "k8s.io/kubernetes/pkg/api/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
@@ -24,7 +23,7 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				d.Set("wait_until_bound", true)
-				return []*schema.ResourceData{d}, nil
+				return importNamespacedState(d, meta)
 			},
 		},
 
@@ -41,100 +40,7 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 				ForceNew:    true,
 				MaxItems:    1,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"access_modes": {
-							Type:        schema.TypeSet,
-							Description: "A set of the desired access modes the volume should have. More info: http://kubernetes.io/docs/user-guide/persistent-volumes#access-modes-1",
-							Required:    true,
-							ForceNew:    true,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-							Set:         schema.HashString,
-						},
-						"resources": {
-							Type:        schema.TypeList,
-							Description: "A list of the minimum resources the volume should have. More info: http://kubernetes.io/docs/user-guide/persistent-volumes#resources",
-							Required:    true,
-							ForceNew:    true,
-							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"limits": {
-										Type:        schema.TypeMap,
-										Description: "Map describing the maximum amount of compute resources allowed. More info: http://kubernetes.io/docs/user-guide/compute-resources/",
-										Optional:    true,
-										ForceNew:    true,
-									},
-									"requests": {
-										Type:        schema.TypeMap,
-										Description: "Map describing the minimum amount of compute resources required. If this is omitted for a container, it defaults to `limits` if that is explicitly specified, otherwise to an implementation-defined value. More info: http://kubernetes.io/docs/user-guide/compute-resources/",
-										Optional:    true,
-										ForceNew:    true,
-									},
-								},
-							},
-						},
-						"selector": {
-							Type:        schema.TypeList,
-							Description: "A label query over volumes to consider for binding.",
-							Optional:    true,
-							ForceNew:    true,
-							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"match_expressions": {
-										Type:        schema.TypeList,
-										Description: "A list of label selector requirements. The requirements are ANDed.",
-										Optional:    true,
-										ForceNew:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"key": {
-													Type:        schema.TypeString,
-													Description: "The label key that the selector applies to.",
-													Optional:    true,
-													ForceNew:    true,
-												},
-												"operator": {
-													Type:        schema.TypeString,
-													Description: "A key's relationship to a set of values. Valid operators ard `In`, `NotIn`, `Exists` and `DoesNotExist`.",
-													Optional:    true,
-													ForceNew:    true,
-												},
-												"values": {
-													Type:        schema.TypeSet,
-													Description: "An array of string values. If the operator is `In` or `NotIn`, the values array must be non-empty. If the operator is `Exists` or `DoesNotExist`, the values array must be empty. This array is replaced during a strategic merge patch.",
-													Optional:    true,
-													ForceNew:    true,
-													Elem:        &schema.Schema{Type: schema.TypeString},
-													Set:         schema.HashString,
-												},
-											},
-										},
-									},
-									"match_labels": {
-										Type:        schema.TypeMap,
-										Description: "A map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of `match_expressions`, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
-										Optional:    true,
-										ForceNew:    true,
-									},
-								},
-							},
-						},
-						"volume_name": {
-							Type:        schema.TypeString,
-							Description: "The binding reference to the PersistentVolume backing this claim.",
-							Optional:    true,
-							ForceNew:    true,
-							Computed:    true,
-						},
-						"storage_class_name": {
-							Type:        schema.TypeString,
-							Description: "Name of the storage class requested by the claim",
-							Optional:    true,
-							Computed:    true,
-							ForceNew:    true,
-						},
-					},
+					Schema: persistentVolumeClaimSpecFields(),
 				},
 			},
 			"wait_until_bound": {
@@ -148,9 +54,9 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 }
 
 func resourceKubernetesPersistentVolumeClaimCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandNamespacedMetadata(d, meta)
 	spec, err := expandPersistentVolumeClaimSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return err
@@ -203,7 +109,7 @@ func resourceKubernetesPersistentVolumeClaimCreate(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesPersistentVolumeClaimRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -217,7 +123,7 @@ func resourceKubernetesPersistentVolumeClaimRead(d *schema.ResourceData, meta in
 		return err
 	}
 	log.Printf("[INFO] Received persistent volume claim: %#v", claim)
-	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta))
+	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta, d))
 	if err != nil {
 		return err
 	}
@@ -230,22 +136,23 @@ func resourceKubernetesPersistentVolumeClaimRead(d *schema.ResourceData, meta in
 }
 
 func resourceKubernetesPersistentVolumeClaimUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	// The whole spec is ForceNew = nothing to update there
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-
-	log.Printf("[INFO] Updating persistent volume claim: %s", ops)
-	out, err := conn.CoreV1().PersistentVolumeClaims(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	var out *api.PersistentVolumeClaim
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		// The whole spec is ForceNew = nothing to update there
+		return ops, nil
+	}, resourceKubernetesPersistentVolumeClaimRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating persistent volume claim: %s", data)
+		out, err = conn.CoreV1().PersistentVolumeClaims(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -255,7 +162,7 @@ func resourceKubernetesPersistentVolumeClaimUpdate(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesPersistentVolumeClaimDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -275,7 +182,7 @@ func resourceKubernetesPersistentVolumeClaimDelete(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesPersistentVolumeClaimExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -11,7 +11,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	api "k8s.io/kubernetes/pkg/api/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 func resourceKubernetesPod() *schema.Resource {
@@ -22,8 +21,17 @@ func resourceKubernetesPod() *schema.Resource {
 		Delete: resourceKubernetesPodDelete,
 		Exists: resourceKubernetesPodExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for", "running")
+				return importNamespacedState(d, meta)
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
+
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("pod", true),
 			"spec": {
@@ -35,13 +43,126 @@ func resourceKubernetesPod() *schema.Resource {
 					Schema: podSpecFields(false),
 				},
 			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "Most recently observed status of the pod.",
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:        schema.TypeList,
+							Description: "Current service state of the pod.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"message": {
+										Type:        schema.TypeString,
+										Description: "Human-readable message indicating details about last transition.",
+										Computed:    true,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Description: "Unique, one-word, CamelCase reason for the condition's last transition.",
+										Computed:    true,
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Description: "Status of the condition, one of True, False, Unknown.",
+										Computed:    true,
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Description: "Type of pod condition, e.g. PodScheduled or Ready.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"container_status": {
+							Type:        schema.TypeList,
+							Description: "The status of each container in the pod.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"image": {
+										Type:        schema.TypeString,
+										Description: "The image the container is running.",
+										Computed:    true,
+									},
+									"image_id": {
+										Type:        schema.TypeString,
+										Description: "ID of the container's image.",
+										Computed:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Name of the container.",
+										Computed:    true,
+									},
+									"ready": {
+										Type:        schema.TypeBool,
+										Description: "Whether the container has passed its readiness probe.",
+										Computed:    true,
+									},
+									"restart_count": {
+										Type:        schema.TypeInt,
+										Description: "The number of times the container has been restarted.",
+										Computed:    true,
+									},
+									"state": {
+										Type:        schema.TypeList,
+										Description: "Details about the container's current condition.",
+										Computed:    true,
+										Elem: &schema.Resource{
+											Schema: containerStateFields(),
+										},
+									},
+								},
+							},
+						},
+						"host_ip": {
+							Type:        schema.TypeString,
+							Description: "IP address of the host to which the pod is assigned.",
+							Computed:    true,
+						},
+						"phase": {
+							Type:        schema.TypeString,
+							Description: "Current phase of the pod, one of Pending, Running, Succeeded, Failed, Unknown.",
+							Computed:    true,
+						},
+						"pod_ip": {
+							Type:        schema.TypeString,
+							Description: "IP address allocated to the pod.",
+							Computed:    true,
+						},
+						"qos_class": {
+							Type:        schema.TypeString,
+							Description: "Quality of Service class assigned to the pod based on its resource requirements.",
+							Computed:    true,
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Description: "RFC 3339 date and time at which the pod was acknowledged by the kubelet.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"wait_for": {
+				Type:         schema.TypeString,
+				Description:  "What to wait for after the pod is created. One of `running`, `ready` (all containers pass their readiness probes), `succeeded` (all containers ran to completion) or `none`. A pod which has succeeded always satisfies the wait, one which has failed never does.",
+				Optional:     true,
+				Default:      "running",
+				ValidateFunc: validateAttributeValueIsIn([]string{"running", "ready", "succeeded", "none"}),
+			},
 		},
 	}
 }
 func resourceKubernetesPodCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandNamespacedMetadata(d, meta)
 	spec, err := expandPodSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return err
@@ -64,10 +185,17 @@ func resourceKubernetesPodCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(buildId(out.ObjectMeta))
 
+	waitFor := d.Get("wait_for").(string)
+	if waitFor == "none" {
+		log.Printf("[INFO] Pod %s created", out.Name)
+		return resourceKubernetesPodRead(d, meta)
+	}
+
+	pending, target := podWaitStates(waitFor)
 	stateConf := &resource.StateChangeConf{
-		Target:  []string{"Running"},
-		Pending: []string{"Pending"},
-		Timeout: 5 * time.Minute,
+		Target:  target,
+		Pending: pending,
+		Timeout: d.Timeout(schema.TimeoutCreate),
 		Refresh: func() (interface{}, string, error) {
 			out, err := conn.CoreV1().Pods(metadata.Namespace).Get(metadata.Name, metav1.GetOptions{})
 			if err != nil {
@@ -75,18 +203,18 @@ func resourceKubernetesPodCreate(d *schema.ResourceData, meta interface{}) error
 				return out, "Error", err
 			}
 
-			statusPhase := fmt.Sprintf("%v", out.Status.Phase)
-			log.Printf("[DEBUG] Pods %s status received: %#v", out.Name, statusPhase)
-			return out, statusPhase, nil
+			state, err := podState(out)
+			log.Printf("[DEBUG] Pods %s status received: %#v", out.Name, state)
+			return out, state, err
 		},
 	}
 	_, err = stateConf.WaitForState()
 	if err != nil {
 		lastWarnings, wErr := getLastWarningsForObject(conn, out.ObjectMeta, "Pod", 3)
-		if wErr != nil {
-			return wErr
+		if wErr == nil {
+			err = fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
 		}
-		return fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+		return err
 	}
 	log.Printf("[INFO] Pod %s created", out.Name)
 
@@ -94,29 +222,73 @@ func resourceKubernetesPodCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceKubernetesPodUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	if d.HasChange("spec") {
-		specOps, err := patchPodSpec("/spec", "spec.0.", d)
+	var out *api.Pod
+	patch := func(pt pkgApi.PatchType, data []byte) (err error) {
+		log.Printf("[INFO] Updating pod %s: %s", d.Id(), data)
+		out, err = conn.CoreV1().Pods(namespace).Patch(name, pt, data)
+		return err
+	}
+
+	if meta.(*kubeProvider).strategicMergePatch {
+		if d.HasChange("spec.0.active_deadline_seconds") {
+			o, n := d.GetChange("spec.0.active_deadline_seconds")
+			err := checkActiveDeadlineSecondsChange(o.(int), n.(int))
+			if err != nil {
+				return err
+			}
+		}
+		oldPod, newPod, err := expandPodChange(d)
 		if err != nil {
 			return err
 		}
-		ops = append(ops, specOps...)
+		if d.HasChange("spec.0.toleration") {
+			// Tolerations aren't merged, so those added by the API server
+			// have to be sent along, as they can't be removed
+			live, err := conn.CoreV1().Pods(namespace).Get(name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			for _, t := range live.Spec.Tolerations {
+				if !containsToleration(oldPod.Spec.Tolerations, t) {
+					oldPod.Spec.Tolerations = append(oldPod.Spec.Tolerations, t)
+					newPod.Spec.Tolerations = append(newPod.Spec.Tolerations, t)
+				}
+			}
+		}
+		err = strategicPatchResource(d, meta, oldPod, newPod, resourceKubernetesPodRead, func(data []byte) error {
+			return patch(pkgApi.StrategicMergePatchType, data)
+		})
+	} else {
+		err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+			ops := patchMetadata("metadata.0.", "/metadata/", d)
+			if d.HasChange("spec") {
+				var liveTolerations []api.Toleration
+				if d.HasChange("spec.0.toleration") {
+					// Tolerations added by the API server aren't kept in state
+					live, err := conn.CoreV1().Pods(namespace).Get(name, metav1.GetOptions{})
+					if err != nil {
+						return nil, err
+					}
+					liveTolerations = live.Spec.Tolerations
+				}
+				specOps, err := patchPodSpec("/spec", "spec.0.", d, liveTolerations)
+				if err != nil {
+					return nil, err
+				}
+				ops = append(ops, specOps...)
+			}
+			return ops, nil
+		}, resourceKubernetesPodRead, func(data []byte) error {
+			return patch(pkgApi.JSONPatchType, data)
+		})
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-
-	log.Printf("[INFO] Updating pod %s: %s", d.Id(), ops)
-
-	out, err := conn.CoreV1().Pods(namespace).Patch(name, pkgApi.JSONPatchType, data)
 	if err != nil {
 		return err
 	}
@@ -127,7 +299,7 @@ func resourceKubernetesPodUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceKubernetesPodRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -142,10 +314,16 @@ func resourceKubernetesPodRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[INFO] Received pod: %#v", pod)
 
-	err = d.Set("metadata", flattenMetadata(pod.ObjectMeta))
+	err = d.Set("metadata", flattenMetadata(pod.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	configured, err := expandTolerations(d.Get("spec.0.toleration").([]interface{}))
 	if err != nil {
 		return err
 	}
+	pod.Spec.Tolerations = removeDefaultTolerations(pod.Spec.Tolerations, configured)
 
 	podSpec, err := flattenPodSpec(pod.Spec)
 	if err != nil {
@@ -156,12 +334,17 @@ func resourceKubernetesPodRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
+
+	err = d.Set("status", flattenPodStatus(pod.Status))
+	if err != nil {
+		return err
+	}
 	return nil
 
 }
 
 func resourceKubernetesPodDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -174,7 +357,7 @@ func resourceKubernetesPodDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		out, err := conn.CoreV1().Pods(namespace).Get(name, metav1.GetOptions{})
 		if err != nil {
 			if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
@@ -198,7 +381,7 @@ func resourceKubernetesPodDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceKubernetesPodExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -215,3 +398,108 @@ func resourceKubernetesPodExists(d *schema.ResourceData, meta interface{}) (bool
 	}
 	return true, err
 }
+
+// expandPodChange returns the pod as it was last applied and as it's
+// now configured
+func expandPodChange(d *schema.ResourceData) (*api.Pod, *api.Pod, error) {
+	oldMeta, newMeta := d.GetChange("metadata")
+	oldSpec, newSpec := d.GetChange("spec")
+
+	oldPod := &api.Pod{ObjectMeta: expandMetadata(oldMeta.([]interface{}))}
+	spec, err := expandPodSpec(oldSpec.([]interface{}))
+	if err != nil {
+		return nil, nil, err
+	}
+	oldPod.Spec = spec
+
+	newPod := &api.Pod{ObjectMeta: expandMetadata(newMeta.([]interface{}))}
+	spec, err = expandPodSpec(newSpec.([]interface{}))
+	if err != nil {
+		return nil, nil, err
+	}
+	newPod.Spec = spec
+
+	return oldPod, newPod, nil
+}
+
+// podWaitStates returns the pending and target states of a pod being waited
+// for as requested by wait_for
+func podWaitStates(waitFor string) ([]string, []string) {
+	switch waitFor {
+	case "ready":
+		return []string{"Pending", "Running"}, []string{"Ready", "Succeeded"}
+	case "succeeded":
+		return []string{"Pending", "Running", "Ready"}, []string{"Succeeded"}
+	}
+	return []string{"Pending"}, []string{"Running", "Ready", "Succeeded"}
+}
+
+// podState returns the phase of the pod, or Ready if it's running and all of
+// its containers are ready. A failed pod is reported as an error explaining
+// why its containers terminated.
+func podState(pod *api.Pod) (string, error) {
+	switch pod.Status.Phase {
+	case api.PodFailed:
+		msg := fmt.Sprintf("Pod %s/%s failed", pod.Namespace, pod.Name)
+		if pod.Status.Reason != "" {
+			msg += fmt.Sprintf(" (%s: %s)", pod.Status.Reason, pod.Status.Message)
+		}
+		return "Failed", fmt.Errorf("%s%s", msg, stringifyContainerTerminations(pod))
+	case api.PodPending:
+		if err := initContainerFailure(pod); err != nil {
+			return "Failed", err
+		}
+	case api.PodRunning:
+		for _, c := range pod.Status.Conditions {
+			if c.Type == api.PodReady && c.Status == api.ConditionTrue {
+				return "Ready", nil
+			}
+		}
+	}
+	return string(pod.Status.Phase), nil
+}
+
+// initContainerFailure returns an error if one of the init containers of a
+// pending pod keeps failing, which would otherwise leave the pod pending
+// until the wait times out
+func initContainerFailure(pod *api.Pod) error {
+	for _, cs := range pod.Status.InitContainerStatuses {
+		w := cs.State.Waiting
+		if w == nil || w.Reason != "CrashLoopBackOff" {
+			continue
+		}
+		msg := fmt.Sprintf("Pod %s/%s failed to initialize: init container %q is in Init:%s", pod.Namespace, pod.Name, cs.Name, w.Reason)
+		if t := cs.LastTerminationState.Terminated; t != nil {
+			msg += fmt.Sprintf("\n   * last terminated with exit code %d", t.ExitCode)
+			if t.Reason != "" {
+				msg += fmt.Sprintf(" (%s)", t.Reason)
+			}
+			if t.Message != "" {
+				msg += fmt.Sprintf(": %s", t.Message)
+			}
+		}
+		return fmt.Errorf("%s", msg)
+	}
+	return nil
+}
+
+func stringifyContainerTerminations(pod *api.Pod) string {
+	var output string
+	statuses := make([]api.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, cs := range statuses {
+		t := cs.State.Terminated
+		if t == nil {
+			continue
+		}
+		output += fmt.Sprintf("\n   * container %q terminated with exit code %d", cs.Name, t.ExitCode)
+		if t.Reason != "" {
+			output += fmt.Sprintf(" (%s)", t.Reason)
+		}
+		if t.Message != "" {
+			output += fmt.Sprintf(": %s", t.Message)
+		}
+	}
+	return output
+}
@@ -0,0 +1,205 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	policy "k8s.io/kubernetes/pkg/apis/policy/v1beta1"
+)
+
+func resourceKubernetesPodDisruptionBudget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesPodDisruptionBudgetCreate,
+		Read:   resourceKubernetesPodDisruptionBudgetRead,
+		Exists: resourceKubernetesPodDisruptionBudgetExists,
+		Update: resourceKubernetesPodDisruptionBudgetUpdate,
+		Delete: resourceKubernetesPodDisruptionBudgetDelete,
+		Importer: &schema.ResourceImporter{
+			State: importNamespacedState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("pod disruption budget", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the behavior of a pod disruption budget. The spec cannot be updated once created.",
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_available": {
+							Type:         schema.TypeString,
+							Description:  "An eviction is allowed if at least this many pods selected by the selector will still be available after the eviction. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateIntOrPercent,
+						},
+						"selector": {
+							Type:        schema.TypeList,
+							Description: "A label query over pods whose evictions are managed by the disruption budget.",
+							Required:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: generateLabelSelector(false),
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "Most recently observed status of the pod disruption budget.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_healthy": {
+							Type:        schema.TypeInt,
+							Description: "Current number of healthy pods.",
+							Computed:    true,
+						},
+						"desired_healthy": {
+							Type:        schema.TypeInt,
+							Description: "Minimum desired number of healthy pods.",
+							Computed:    true,
+						},
+						"disruptions_allowed": {
+							Type:        schema.TypeInt,
+							Description: "Number of pod disruptions that are currently allowed.",
+							Computed:    true,
+						},
+						"expected_pods": {
+							Type:        schema.TypeInt,
+							Description: "Total number of pods counted by this disruption budget.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesPodDisruptionBudgetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	metadata := expandNamespacedMetadata(d, meta)
+	pdb := policy.PodDisruptionBudget{
+		ObjectMeta: metadata,
+		Spec:       expandPodDisruptionBudgetSpec(d.Get("spec").([]interface{})),
+	}
+	log.Printf("[INFO] Creating new pod disruption budget: %#v", pdb)
+	out, err := conn.PolicyV1beta1().PodDisruptionBudgets(metadata.Namespace).Create(&pdb)
+	if err != nil {
+		return fmt.Errorf("Failed to create pod disruption budget: %s", err)
+	}
+	log.Printf("[INFO] Submitted new pod disruption budget: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesPodDisruptionBudgetRead(d, meta)
+}
+
+func resourceKubernetesPodDisruptionBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading pod disruption budget %s", name)
+	pdb, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received pod disruption budget: %#v", pdb)
+	err = d.Set("metadata", flattenMetadata(pdb.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	flattened := flattenPodDisruptionBudgetSpec(pdb.Spec)
+	log.Printf("[DEBUG] Flattened pod disruption budget spec: %#v", flattened)
+	err = d.Set("spec", flattened)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("status", flattenPodDisruptionBudgetStatus(pdb.Status))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesPodDisruptionBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var out *policy.PodDisruptionBudget
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		return ops, nil
+	}, resourceKubernetesPodDisruptionBudgetRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating pod disruption budget %q: %v", name, string(data))
+		out, err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update pod disruption budget: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated pod disruption budget: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesPodDisruptionBudgetRead(d, meta)
+}
+
+func resourceKubernetesPodDisruptionBudgetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting pod disruption budget: %#v", name)
+	err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Pod disruption budget %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesPodDisruptionBudgetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking pod disruption budget %s", name)
+	_, err = conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
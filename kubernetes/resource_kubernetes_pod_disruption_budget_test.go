@@ -0,0 +1,178 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	policy "k8s.io/kubernetes/pkg/apis/policy/v1beta1"
+)
+
+func TestAccKubernetesPodDisruptionBudget_basic(t *testing.T) {
+	var conf policy.PodDisruptionBudget
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_pod_disruption_budget.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesPodDisruptionBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodDisruptionBudgetConfig_basic(name, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodDisruptionBudgetExists("kubernetes_pod_disruption_budget.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "metadata.0.annotations.%", "0"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{}),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "metadata.0.labels.%", "0"),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_pod_disruption_budget.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_pod_disruption_budget.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_pod_disruption_budget.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_pod_disruption_budget.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "spec.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "spec.0.min_available", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "spec.0.selector.0.match_labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "spec.0.selector.0.match_labels.app", "zookeeper"),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "status.#", "1"),
+					resource.TestCheckResourceAttrSet("kubernetes_pod_disruption_budget.test", "status.0.disruptions_allowed"),
+				),
+			},
+			{
+				Config: testAccKubernetesPodDisruptionBudgetConfig_basic(name, "50%"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodDisruptionBudgetExists("kubernetes_pod_disruption_budget.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "spec.0.min_available", "50%"),
+				),
+			},
+			{
+				Config: testAccKubernetesPodDisruptionBudgetConfig_metaModified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodDisruptionBudgetExists("kubernetes_pod_disruption_budget.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "metadata.0.labels.TestLabelOne", "one"),
+					testAccCheckMetaLabels(&conf.ObjectMeta, map[string]string{"TestLabelOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_pod_disruption_budget.test", "spec.0.min_available", "50%"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPodDisruptionBudget_importBasic(t *testing.T) {
+	resourceName := "kubernetes_pod_disruption_budget.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDisruptionBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodDisruptionBudgetConfig_basic(name, "1"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesPodDisruptionBudgetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubeProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_pod_disruption_budget" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(name, metav1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Pod disruption budget still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesPodDisruptionBudgetExists(n string, obj *policy.PodDisruptionBudget) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubeProvider).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesPodDisruptionBudgetConfig_basic(name, minAvailable string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod_disruption_budget" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    min_available = "%s"
+    selector {
+      match_labels {
+        app = "zookeeper"
+      }
+    }
+  }
+}
+`, name, minAvailable)
+}
+
+func testAccKubernetesPodDisruptionBudgetConfig_metaModified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod_disruption_budget" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    labels {
+      TestLabelOne = "one"
+    }
+    name = "%s"
+  }
+  spec {
+    min_available = "50%%"
+    selector {
+      match_labels {
+        app = "zookeeper"
+      }
+    }
+  }
+}
+`, name)
+}
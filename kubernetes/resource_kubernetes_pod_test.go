@@ -3,11 +3,11 @@ package kubernetes
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	api "k8s.io/kubernetes/pkg/api/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -44,6 +44,14 @@ func TestAccKubernetesPod_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.env.0.value_from.0.secret_key_ref.0.name", secretName),
 					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.env.1.value_from.0.config_map_key_ref.0.name", configMapName),
 					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.image", imageName1),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.phase", "Running"),
+					resource.TestCheckResourceAttrSet("kubernetes_pod.test", "status.0.pod_ip"),
+					resource.TestCheckResourceAttrSet("kubernetes_pod.test", "status.0.host_ip"),
+					resource.TestCheckResourceAttrSet("kubernetes_pod.test", "status.0.start_time"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.container_status.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.container_status.0.name", "containername"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.container_status.0.image", imageName1),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.container_status.0.state.0.running.#", "1"),
 				),
 			},
 			{
@@ -109,6 +117,305 @@ func TestAccKubernetesPod_updateForceNew(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPod_updateActiveDeadlineSeconds(t *testing.T) {
+	var conf1, conf2 api.Pod
+
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := "nginx:1.7.9"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigActiveDeadlineSeconds(podName, imageName, 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf1),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.active_deadline_seconds", "3600"),
+				),
+			},
+			{
+				Config: testAccKubernetesPodConfigActiveDeadlineSeconds(podName, imageName, 1800),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf2),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.active_deadline_seconds", "1800"),
+					testAccCheckKubernetesPodNotRecreated(&conf1, &conf2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_addTolerations(t *testing.T) {
+	var conf1, conf2 api.Pod
+
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := "nginx:1.7.9"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigTolerations(podName, imageName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf1),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.toleration.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.toleration.0.key", "dedicated"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.toleration.0.operator", "Equal"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.toleration.0.value", "web"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.toleration.0.effect", "NoSchedule"),
+				),
+			},
+			{
+				Config: testAccKubernetesPodConfigTolerations(podName, imageName, `
+    toleration {
+      key                = "flaky"
+      operator           = "Exists"
+      effect             = "NoExecute"
+      toleration_seconds = "60"
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf2),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.toleration.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.toleration.1.key", "flaky"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.toleration.1.toleration_seconds", "60"),
+					testAccCheckKubernetesPodNotRecreated(&conf1, &conf2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_waitForSucceeded(t *testing.T) {
+	var conf api.Pod
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := "busybox:1.27"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigRunToCompletion(podName, imageName, "succeeded", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "wait_for", "succeeded"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.restart_policy", "Never"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_waitForFailed(t *testing.T) {
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := "busybox:1.27"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesPodConfigRunToCompletion(podName, imageName, "running", 3),
+				ExpectError: regexp.MustCompile(`container "containername" terminated with exit code 3`),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_waitForNone(t *testing.T) {
+	var conf api.Pod
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := "busybox:1.27"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigRunToCompletion(podName, imageName, "none", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "wait_for", "none"),
+				),
+			},
+		},
+	})
+}
+
+func TestPodState(t *testing.T) {
+	testCases := []struct {
+		Status        api.PodStatus
+		ExpectedState string
+		ExpectedErr   string
+	}{
+		{
+			Status:        api.PodStatus{Phase: api.PodPending},
+			ExpectedState: "Pending",
+		},
+		{
+			Status: api.PodStatus{
+				Phase: api.PodRunning,
+				Conditions: []api.PodCondition{
+					{Type: api.PodReady, Status: api.ConditionFalse},
+				},
+			},
+			ExpectedState: "Running",
+		},
+		{
+			Status: api.PodStatus{
+				Phase: api.PodRunning,
+				Conditions: []api.PodCondition{
+					{Type: api.PodScheduled, Status: api.ConditionTrue},
+					{Type: api.PodReady, Status: api.ConditionTrue},
+				},
+			},
+			ExpectedState: "Ready",
+		},
+		{
+			Status:        api.PodStatus{Phase: api.PodSucceeded},
+			ExpectedState: "Succeeded",
+		},
+		{
+			Status: api.PodStatus{
+				Phase: api.PodFailed,
+				InitContainerStatuses: []api.ContainerStatus{
+					{
+						Name: "init",
+						State: api.ContainerState{
+							Terminated: &api.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"},
+						},
+					},
+				},
+				ContainerStatuses: []api.ContainerStatus{
+					{
+						Name: "app",
+						State: api.ContainerState{
+							Terminated: &api.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"},
+						},
+					},
+					{
+						Name:  "sidecar",
+						State: api.ContainerState{Running: &api.ContainerStateRunning{}},
+					},
+				},
+			},
+			ExpectedState: "Failed",
+			ExpectedErr: "Pod default/test failed" +
+				"\n   * container \"init\" terminated with exit code 0 (Completed)" +
+				"\n   * container \"app\" terminated with exit code 137 (OOMKilled)",
+		},
+		{
+			Status: api.PodStatus{
+				Phase:   api.PodFailed,
+				Reason:  "DeadlineExceeded",
+				Message: "Pod was active on the node longer than the specified deadline",
+			},
+			ExpectedState: "Failed",
+			ExpectedErr:   "Pod default/test failed (DeadlineExceeded: Pod was active on the node longer than the specified deadline)",
+		},
+		{
+			Status: api.PodStatus{
+				Phase: api.PodPending,
+				InitContainerStatuses: []api.ContainerStatus{
+					{
+						Name: "init",
+						State: api.ContainerState{
+							Waiting: &api.ContainerStateWaiting{Reason: "PodInitializing"},
+						},
+					},
+				},
+			},
+			ExpectedState: "Pending",
+		},
+		{
+			Status: api.PodStatus{
+				Phase: api.PodPending,
+				InitContainerStatuses: []api.ContainerStatus{
+					{
+						Name: "migrate",
+						State: api.ContainerState{
+							Waiting: &api.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+						},
+						LastTerminationState: api.ContainerState{
+							Terminated: &api.ContainerStateTerminated{ExitCode: 1, Reason: "Error"},
+						},
+					},
+				},
+			},
+			ExpectedState: "Failed",
+			ExpectedErr: "Pod default/test failed to initialize: init container \"migrate\" is in Init:CrashLoopBackOff" +
+				"\n   * last terminated with exit code 1 (Error)",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			pod := &api.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+				Status:     tc.Status,
+			}
+			state, err := podState(pod)
+			if state != tc.ExpectedState {
+				t.Fatalf("Expected state %q, got %q", tc.ExpectedState, state)
+			}
+			if tc.ExpectedErr == "" && err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if tc.ExpectedErr != "" && (err == nil || err.Error() != tc.ExpectedErr) {
+				t.Fatalf("Expected error:\n%s\nGot:\n%v", tc.ExpectedErr, err)
+			}
+		})
+	}
+}
+
+func TestAccKubernetesPod_with_init_container(t *testing.T) {
+	var conf api.Pod
+
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigWithInitContainer(podName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.init_container.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.init_container.0.name", "init"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.init_container.0.image", "busybox:1.27"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.init_container.0.command.#", "3"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.name", "containername"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_initContainerCrashLoop(t *testing.T) {
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesPodConfigWithInitContainer(podName, 1),
+				ExpectError: regexp.MustCompile(`init container "init" is in Init:CrashLoopBackOff`),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPod_importBasic(t *testing.T) {
 	resourceName := "kubernetes_pod.test"
 	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -331,6 +638,36 @@ func TestAccKubernetesPod_with_volume_mount(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPod_with_env_from(t *testing.T) {
+	var conf api.Pod
+
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	secretName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	configMapName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := "nginx:1.7.9"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigWithEnvFrom(secretName, configMapName, podName, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.env_from.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.env_from.0.config_map_ref.0.name", configMapName),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.env_from.0.config_map_ref.0.optional", "true"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.env_from.0.prefix", "FROM_CM_"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.env_from.1.secret_ref.0.name", secretName),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.env_from.1.secret_ref.0.optional", "false"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.env_from.1.prefix", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPod_with_cfg_map_volume_mount(t *testing.T) {
 	var conf api.Pod
 
@@ -442,6 +779,65 @@ func TestAccKubernetesPod_with_secret_vol_items(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPod_with_affinity(t *testing.T) {
+	var conf api.Pod
+
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := "nginx:1.7.9"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigWithAffinity(podName, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.affinity.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.affinity.0.node_affinity.0.preferred_during_scheduling_ignored_during_execution.0.weight", "10"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.affinity.0.node_affinity.0.preferred_during_scheduling_ignored_during_execution.0.preference.0.match_expressions.0.key", "disktype"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.affinity.0.node_affinity.0.preferred_during_scheduling_ignored_during_execution.0.preference.0.match_expressions.0.values.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.affinity.0.pod_anti_affinity.0.preferred_during_scheduling_ignored_during_execution.0.weight", "100"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.affinity.0.pod_anti_affinity.0.preferred_during_scheduling_ignored_during_execution.0.pod_affinity_term.0.label_selector.0.match_labels.app", "pod_label"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.affinity.0.pod_anti_affinity.0.preferred_during_scheduling_ignored_during_execution.0.pod_affinity_term.0.topology_key", "kubernetes.io/hostname"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_with_projected_volume(t *testing.T) {
+	var conf api.Pod
+
+	secretName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	configMapName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := "nginx:1.7.9"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigWithProjectedVolume(secretName, configMapName, podName, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.volume.0.projected.0.default_mode", "420"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.volume.0.projected.0.sources.#", "3"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.volume.0.projected.0.sources.0.secret.0.name", secretName),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.volume.0.projected.0.sources.0.secret.0.items.0.key", "one"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.volume.0.projected.0.sources.0.secret.0.items.0.mode", "256"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.volume.0.projected.0.sources.1.config_map.0.name", configMapName),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.volume.0.projected.0.sources.2.downward_api.0.items.0.path", "labels"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.volume.0.projected.0.sources.2.downward_api.0.items.0.field_ref.0.field_path", "metadata.labels"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPod_gke_with_nodeSelector(t *testing.T) {
 	var conf api.Pod
 
@@ -468,7 +864,7 @@ func TestAccKubernetesPod_gke_with_nodeSelector(t *testing.T) {
 }
 
 func testAccCheckKubernetesPodDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*kubernetes.Clientset)
+	conn := testAccProvider.Meta().(*kubeProvider).conn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "kubernetes_pod" {
@@ -498,7 +894,7 @@ func testAccCheckKubernetesPodExists(n string, obj *api.Pod) resource.TestCheckF
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*kubernetes.Clientset)
+		conn := testAccProvider.Meta().(*kubeProvider).conn
 
 		namespace, name, err := idParts(rs.Primary.ID)
 		if err != nil {
@@ -514,6 +910,15 @@ func testAccCheckKubernetesPodExists(n string, obj *api.Pod) resource.TestCheckF
 	}
 }
 
+func testAccCheckKubernetesPodNotRecreated(before, after *api.Pod) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.UID != after.UID {
+			return fmt.Errorf("Expected pod to be updated in place, but it was recreated (UID %s -> %s)", before.UID, after.UID)
+		}
+		return nil
+	}
+}
+
 func testAccKubernetesPodConfigBasic(secretName, configMapName, podName, imageName string) string {
 	return fmt.Sprintf(`
 
@@ -860,6 +1265,57 @@ resource "kubernetes_pod" "test" {
 	`, secretName, podName, imageName)
 }
 
+func testAccKubernetesPodConfigWithEnvFrom(secretName, configMapName, podName, imageName string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_secret" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  data {
+    one = "first"
+  }
+}
+
+resource "kubernetes_config_map" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  data {
+    one = "ONE"
+  }
+}
+
+resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    container {
+      image = "%s"
+      name  = "containername"
+
+      env_from {
+        config_map_ref {
+          name     = "${kubernetes_config_map.test.metadata.0.name}"
+          optional = true
+        }
+        prefix = "FROM_CM_"
+      }
+
+      env_from {
+        secret_ref {
+          name = "${kubernetes_secret.test.metadata.0.name}"
+        }
+      }
+    }
+  }
+}
+`, secretName, configMapName, podName, imageName)
+}
+
 func testAccKubernetesPodConfigWithConfigMapVolume(secretName, podName, imageName string) string {
 	return fmt.Sprintf(`
 resource "kubernetes_config_map" "test" {
@@ -967,6 +1423,135 @@ resource "kubernetes_pod" "test" {
 `, podName, imageName)
 }
 
+func testAccKubernetesPodConfigWithAffinity(podName, imageName string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod" "test" {
+  metadata {
+    labels {
+      app = "pod_label"
+    }
+
+    name = "%s"
+  }
+  spec {
+    affinity {
+      node_affinity {
+        preferred_during_scheduling_ignored_during_execution {
+          weight = 10
+          preference {
+            match_expressions {
+              key      = "disktype"
+              operator = "In"
+              values   = ["ssd"]
+            }
+          }
+        }
+      }
+      pod_anti_affinity {
+        preferred_during_scheduling_ignored_during_execution {
+          weight = 100
+          pod_affinity_term {
+            label_selector {
+              match_labels {
+                app = "pod_label"
+              }
+            }
+            topology_key = "kubernetes.io/hostname"
+          }
+        }
+      }
+    }
+    container {
+      image = "%s"
+      name  = "containername"
+    }
+  }
+}
+	`, podName, imageName)
+}
+
+func testAccKubernetesPodConfigWithProjectedVolume(secretName, configMapName, podName, imageName string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_secret" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  data {
+    one = "first"
+  }
+}
+
+resource "kubernetes_config_map" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  data {
+    two = "second"
+  }
+}
+
+resource "kubernetes_pod" "test" {
+  metadata {
+    labels {
+      app = "pod_label"
+    }
+
+    name = "%s"
+  }
+
+  spec {
+    container {
+      image = "%s"
+      name  = "containername"
+
+      volume_mount {
+        mount_path = "/tmp/all-in-one"
+        name       = "all-in-one"
+      }
+    }
+
+    volume {
+      name = "all-in-one"
+
+      projected {
+        sources {
+          secret {
+            name = "${kubernetes_secret.test.metadata.0.name}"
+
+            items {
+              key  = "one"
+              path = "secrets/one"
+              mode = 0400
+            }
+          }
+        }
+
+        sources {
+          config_map {
+            name = "${kubernetes_config_map.test.metadata.0.name}"
+          }
+        }
+
+        sources {
+          downward_api {
+            items {
+              path = "labels"
+
+              field_ref {
+                field_path = "metadata.labels"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, secretName, configMapName, podName, imageName)
+}
+
 func testAccKubernetesPodConfigNodeSelector(podName, imageName, region string) string {
 	return fmt.Sprintf(`
 resource "kubernetes_pod" "test" {
@@ -987,6 +1572,49 @@ resource "kubernetes_pod" "test" {
 `, podName, imageName, region)
 }
 
+func testAccKubernetesPodConfigActiveDeadlineSeconds(podName, imageName string, deadline int) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    active_deadline_seconds = %d
+
+    container {
+      image = "%s"
+      name  = "containername"
+    }
+  }
+}
+`, podName, deadline, imageName)
+}
+
+func testAccKubernetesPodConfigTolerations(podName, imageName, extraTolerations string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    toleration {
+      key    = "dedicated"
+      value  = "web"
+      effect = "NoSchedule"
+    }
+%s
+
+    container {
+      image = "%s"
+      name  = "containername"
+    }
+  }
+}
+`, podName, extraTolerations, imageName)
+}
+
 func testAccKubernetesPodConfigArgsUpdate(podName, imageName, args string) string {
 	return fmt.Sprintf(`
 resource "kubernetes_pod" "test" {
@@ -1004,3 +1632,48 @@ resource "kubernetes_pod" "test" {
 }
 `, podName, imageName, args)
 }
+
+func testAccKubernetesPodConfigWithInitContainer(podName string, exitCode int) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    init_container {
+      image   = "busybox:1.27"
+      name    = "init"
+      command = ["sh", "-c", "exit %d"]
+    }
+
+    container {
+      image = "nginx:1.7.9"
+      name  = "containername"
+    }
+  }
+}
+`, podName, exitCode)
+}
+
+func testAccKubernetesPodConfigRunToCompletion(podName, imageName, waitFor string, exitCode int) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    restart_policy = "Never"
+
+    container {
+      image   = "%s"
+      name    = "containername"
+      command = ["sh", "-c", "exit %d"]
+    }
+  }
+
+  wait_for = "%s"
+}
+`, podName, imageName, exitCode, waitFor)
+}
@@ -22,7 +22,7 @@ func resourceKubernetesReplicationController() *schema.Resource {
 		Update: resourceKubernetesReplicationControllerUpdate,
 		Delete: resourceKubernetesReplicationControllerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importNamespacedState,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -33,6 +33,37 @@ func resourceKubernetesReplicationController() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("replication controller", true),
+			"rolling_update": {
+				Type:        schema.TypeList,
+				Description: "Replace the pods of the replication controller step by step whenever its template changes, the way `kubectl rolling-update` does. Without it, changes to the template only apply to pods created afterwards.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_unavailable": {
+							Type:         schema.TypeString,
+							Description:  "The maximum number of pods (e.g. 2) or percentage of the desired replicas (e.g. 10%) which can be unavailable during the update. Also the number of pods replaced in each step, so it must be greater than 0. Percentages are rounded up.",
+							Optional:     true,
+							Default:      "1",
+							ValidateFunc: validatePositiveIntOrPercent,
+						},
+						"timeout": {
+							Type:         schema.TypeString,
+							Description:  "How long to wait for each step of the update to complete before giving up, e.g. 5m.",
+							Optional:     true,
+							Default:      "5m",
+							ValidateFunc: validateDuration,
+						},
+						"update_period": {
+							Type:         schema.TypeString,
+							Description:  "How long to wait between the steps of the update, e.g. 1m.",
+							Optional:     true,
+							Default:      "1m",
+							ValidateFunc: validateDuration,
+						},
+					},
+				},
+			},
 			"spec": {
 				Type:        schema.TypeList,
 				Description: "Spec defines the specification of the desired behavior of the replication controller. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#spec-and-status",
@@ -74,9 +105,9 @@ func resourceKubernetesReplicationController() *schema.Resource {
 }
 
 func resourceKubernetesReplicationControllerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandNamespacedMetadata(d, meta)
 	spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
 	if err != nil {
 		return err
@@ -115,7 +146,7 @@ func resourceKubernetesReplicationControllerCreate(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesReplicationControllerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -130,7 +161,7 @@ func resourceKubernetesReplicationControllerRead(d *schema.ResourceData, meta in
 	}
 	log.Printf("[INFO] Received replication controller: %#v", rc)
 
-	err = d.Set("metadata", flattenMetadata(rc.ObjectMeta))
+	err = d.Set("metadata", flattenMetadata(rc.ObjectMeta, d))
 	if err != nil {
 		return err
 	}
@@ -149,32 +180,78 @@ func resourceKubernetesReplicationControllerRead(d *schema.ResourceData, meta in
 }
 
 func resourceKubernetesReplicationControllerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-
-	if d.HasChange("spec") {
-		spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
+	if d.HasChange("spec.0.template") {
+		cfg, err := expandRollingUpdateConfig(d.Get("rolling_update").([]interface{}))
 		if err != nil {
 			return err
 		}
+		if cfg != nil {
+			cfg.Deadline = time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+			metadata := expandMetadata(d.Get("metadata").([]interface{}))
+			metadata.Namespace, metadata.Name = namespace, name
+			spec, err := expandReplicationControllerSpec(d.Get("spec").([]interface{}))
+			if err != nil {
+				return err
+			}
+			spec.Template.Spec.AutomountServiceAccountToken = ptrToBool(false)
+
+			log.Printf("[INFO] Rolling update of replication controller %s", d.Id())
+			err = rollingUpdateReplicationController(conn, metadata, spec, cfg)
+			if err != nil {
+				return fmt.Errorf("Rolling update of replication controller %s failed: %s", d.Id(), err)
+			}
+			return resourceKubernetesReplicationControllerRead(d, meta)
+		}
+	}
 
-		ops = append(ops, &ReplaceOperation{
-			Path:  "/spec",
-			Value: spec,
-		})
+	var out *api.ReplicationController
+	patch := func(pt pkgApi.PatchType, data []byte) (err error) {
+		log.Printf("[INFO] Updating replication controller %q: %v", name, string(data))
+		out, err = conn.CoreV1().ReplicationControllers(namespace).Patch(name, pt, data)
+		return err
 	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
+
+	if meta.(*kubeProvider).strategicMergePatch {
+		oldRC, newRC, err := expandReplicationControllerChange(d)
+		if err != nil {
+			return err
+		}
+		err = strategicPatchResource(d, meta, oldRC, newRC, resourceKubernetesReplicationControllerRead, func(data []byte) error {
+			return patch(pkgApi.StrategicMergePatchType, data)
+		})
+	} else {
+		err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+			ops := patchMetadata("metadata.0.", "/metadata/", d)
+
+			if d.HasChange("spec") {
+				o, n := d.GetChange("spec")
+				oldSpec, err := expandReplicationControllerSpec(o.([]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				newSpec, err := expandReplicationControllerSpec(n.([]interface{}))
+				if err != nil {
+					return nil, err
+				}
+
+				diffOps, err := diffObjects("/spec", oldSpec, newSpec)
+				if err != nil {
+					return nil, err
+				}
+				ops = append(ops, diffOps...)
+			}
+			return ops, nil
+		}, resourceKubernetesReplicationControllerRead, func(data []byte) error {
+			return patch(pkgApi.JSONPatchType, data)
+		})
 	}
-	log.Printf("[INFO] Updating replication controller %q: %v", name, string(data))
-	out, err := conn.CoreV1().ReplicationControllers(namespace).Patch(name, pkgApi.JSONPatchType, data)
 	if err != nil {
 		return fmt.Errorf("Failed to update replication controller: %s", err)
 	}
@@ -190,7 +267,7 @@ func resourceKubernetesReplicationControllerUpdate(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesReplicationControllerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -233,7 +310,7 @@ func resourceKubernetesReplicationControllerDelete(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesReplicationControllerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -270,3 +347,26 @@ func waitForDesiredReplicasFunc(conn *kubernetes.Clientset, ns, name string) res
 			desiredReplicas, rc.GetName(), rc.Status.FullyLabeledReplicas))
 	}
 }
+
+// expandReplicationControllerChange returns the replication controller as it
+// was last applied and as it's now configured
+func expandReplicationControllerChange(d *schema.ResourceData) (*api.ReplicationController, *api.ReplicationController, error) {
+	oldMeta, newMeta := d.GetChange("metadata")
+	oldSpec, newSpec := d.GetChange("spec")
+
+	oldRC := &api.ReplicationController{ObjectMeta: expandMetadata(oldMeta.([]interface{}))}
+	spec, err := expandReplicationControllerSpec(oldSpec.([]interface{}))
+	if err != nil {
+		return nil, nil, err
+	}
+	oldRC.Spec = spec
+
+	newRC := &api.ReplicationController{ObjectMeta: expandMetadata(newMeta.([]interface{}))}
+	spec, err = expandReplicationControllerSpec(newSpec.([]interface{}))
+	if err != nil {
+		return nil, nil, err
+	}
+	newRC.Spec = spec
+
+	return oldRC, newRC, nil
+}
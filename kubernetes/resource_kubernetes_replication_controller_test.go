@@ -9,8 +9,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	api "k8s.io/kubernetes/pkg/api/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 func TestAccKubernetesReplicationController_basic(t *testing.T) {
@@ -386,8 +386,69 @@ func TestAccKubernetesReplicationController_with_empty_dir_volume(t *testing.T)
 	})
 }
 
+func TestAccKubernetesReplicationController_rollingUpdate(t *testing.T) {
+	var before, after api.ReplicationController
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_replication_controller.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesReplicationControllerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesReplicationControllerConfigRollingUpdate(name, "nginx:1.7.8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesReplicationControllerExists("kubernetes_replication_controller.test", &before),
+					resource.TestCheckResourceAttr("kubernetes_replication_controller.test", "rolling_update.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_replication_controller.test", "rolling_update.0.max_unavailable", "1"),
+					resource.TestCheckResourceAttr("kubernetes_replication_controller.test", "rolling_update.0.update_period", "1s"),
+					resource.TestCheckResourceAttr("kubernetes_replication_controller.test", "rolling_update.0.timeout", "5m"),
+					testAccCheckKubernetesReplicationControllerPodImages(&before, "nginx:1.7.8", 3),
+				),
+			},
+			{
+				Config: testAccKubernetesReplicationControllerConfigRollingUpdate(name, "nginx:1.7.9"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesReplicationControllerExists("kubernetes_replication_controller.test", &after),
+					resource.TestCheckResourceAttr("kubernetes_replication_controller.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_replication_controller.test", "spec.0.selector.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_replication_controller.test", "spec.0.template.0.container.0.image", "nginx:1.7.9"),
+					testAccCheckKubernetesReplicationControllerPodImages(&after, "nginx:1.7.9", 3),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesReplicationControllerPodImages(rc *api.ReplicationController, image string, replicas int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*kubeProvider).conn
+
+		selector := labels.SelectorFromSet(labels.Set(rc.Spec.Selector)).String()
+		pods, err := conn.CoreV1().Pods(rc.Namespace).List(meta_v1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return err
+		}
+		running := 0
+		for _, pod := range pods.Items {
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+			if pod.Spec.Containers[0].Image != image {
+				return fmt.Errorf("Expected pod %s to run %q, it runs %q", pod.Name, image, pod.Spec.Containers[0].Image)
+			}
+			running++
+		}
+		if running != replicas {
+			return fmt.Errorf("Expected %d pods running %q, got %d", replicas, image, running)
+		}
+		return nil
+	}
+}
+
 func testAccCheckKubernetesReplicationControllerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*kubernetes.Clientset)
+	conn := testAccProvider.Meta().(*kubeProvider).conn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "kubernetes_replication_controller" {
@@ -417,7 +478,7 @@ func testAccCheckKubernetesReplicationControllerExists(n string, obj *api.Replic
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*kubernetes.Clientset)
+		conn := testAccProvider.Meta().(*kubeProvider).conn
 
 		namespace, name, err := idParts(rs.Primary.ID)
 		if err != nil {
@@ -848,3 +909,32 @@ resource "kubernetes_replication_controller" "test" {
 }
 `, rcName, imageName)
 }
+
+func testAccKubernetesReplicationControllerConfigRollingUpdate(name, imageName string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_replication_controller" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    replicas = 3
+
+    selector {
+      app = "%s"
+    }
+
+    template {
+      container {
+        image = "%s"
+        name  = "tf-acc-test"
+      }
+    }
+  }
+
+  rolling_update {
+    update_period = "1s"
+  }
+}
+`, name, name, imageName)
+}
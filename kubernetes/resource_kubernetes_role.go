@@ -0,0 +1,146 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	api "k8s.io/kubernetes/pkg/apis/rbac/v1beta1"
+)
+
+func resourceKubernetesRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesRoleCreate,
+		Read:   resourceKubernetesRoleRead,
+		Exists: resourceKubernetesRoleExists,
+		Update: resourceKubernetesRoleUpdate,
+		Delete: resourceKubernetesRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: importNamespacedState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("role", true),
+			"rule":     policyRuleSchema(),
+		},
+	}
+}
+
+func resourceKubernetesRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	metadata := expandNamespacedMetadata(d, meta)
+	role := api.Role{
+		ObjectMeta: metadata,
+		Rules:      expandRBACPolicyRules(d.Get("rule").([]interface{})),
+	}
+	log.Printf("[INFO] Creating new role: %#v", role)
+	out, err := conn.RbacV1beta1().Roles(metadata.Namespace).Create(&role)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new role: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesRoleRead(d, meta)
+}
+
+func resourceKubernetesRoleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading role %s", name)
+	role, err := conn.RbacV1beta1().Roles(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received role: %#v", role)
+	err = d.Set("metadata", flattenMetadata(role.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+	err = d.Set("rule", flattenRBACPolicyRules(role.Rules))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var out *api.Role
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		diffOps, err := patchRBACPolicyRules("", "/", d)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, diffOps...)
+		return ops, nil
+	}, resourceKubernetesRoleRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating role %q: %v", name, string(data))
+		out, err = conn.RbacV1beta1().Roles(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update role: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated role: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesRoleRead(d, meta)
+}
+
+func resourceKubernetesRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting role: %#v", name)
+	err = conn.RbacV1beta1().Roles(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Role %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking role %s", name)
+	_, err = conn.RbacV1beta1().Roles(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
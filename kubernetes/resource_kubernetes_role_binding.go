@@ -0,0 +1,152 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	api "k8s.io/kubernetes/pkg/apis/rbac/v1beta1"
+)
+
+func resourceKubernetesRoleBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesRoleBindingCreate,
+		Read:   resourceKubernetesRoleBindingRead,
+		Exists: resourceKubernetesRoleBindingExists,
+		Update: resourceKubernetesRoleBindingUpdate,
+		Delete: resourceKubernetesRoleBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: importNamespacedState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("role binding", true),
+			"role_ref": roleRefSchema([]string{"Role", "ClusterRole"}),
+			"subject":  subjectSchema(),
+		},
+	}
+}
+
+func resourceKubernetesRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	metadata := expandNamespacedMetadata(d, meta)
+	binding := api.RoleBinding{
+		ObjectMeta: metadata,
+		RoleRef:    expandRBACRoleRef(d.Get("role_ref").([]interface{})),
+		Subjects:   expandRBACSubjects(d.Get("subject").([]interface{})),
+	}
+	log.Printf("[INFO] Creating new role binding: %#v", binding)
+	out, err := conn.RbacV1beta1().RoleBindings(metadata.Namespace).Create(&binding)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Submitted new role binding: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesRoleBindingRead(d, meta)
+}
+
+func resourceKubernetesRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading role binding %s", name)
+	binding, err := conn.RbacV1beta1().RoleBindings(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received role binding: %#v", binding)
+	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+	err = d.Set("role_ref", flattenRBACRoleRef(binding.RoleRef))
+	if err != nil {
+		return err
+	}
+	err = d.Set("subject", flattenRBACSubjects(binding.Subjects))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var out *api.RoleBinding
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		diffOps, err := patchRBACSubjects("", "/", d)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, diffOps...)
+		return ops, nil
+	}, resourceKubernetesRoleBindingRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating role binding %q: %v", name, string(data))
+		out, err = conn.RbacV1beta1().RoleBindings(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update role binding: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated role binding: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesRoleBindingRead(d, meta)
+}
+
+func resourceKubernetesRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting role binding: %#v", name)
+	err = conn.RbacV1beta1().RoleBindings(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Role binding %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesRoleBindingExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking role binding %s", name)
+	_, err = conn.RbacV1beta1().RoleBindings(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
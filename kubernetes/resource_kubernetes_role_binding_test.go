@@ -0,0 +1,200 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/kubernetes/pkg/apis/rbac/v1beta1"
+)
+
+func TestAccKubernetesRoleBinding_basic(t *testing.T) {
+	var conf api.RoleBinding
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_role_binding.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesRoleBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesRoleBindingConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRoleBindingExists("kubernetes_role_binding.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_role_binding.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_role_binding.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_role_binding.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_role_binding.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "role_ref.0.api_group", "rbac.authorization.k8s.io"),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "role_ref.0.kind", "Role"),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "role_ref.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "subject.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "subject.0.kind", "ServiceAccount"),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "subject.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "subject.0.namespace", "default"),
+				),
+			},
+			{
+				Config: testAccKubernetesRoleBindingConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRoleBindingExists("kubernetes_role_binding.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "subject.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "subject.1.api_group", "rbac.authorization.k8s.io"),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "subject.1.kind", "User"),
+					resource.TestCheckResourceAttr("kubernetes_role_binding.test", "subject.1.name", "jane"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesRoleBinding_importBasic(t *testing.T) {
+	resourceName := "kubernetes_role_binding.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesRoleBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesRoleBindingConfig_basic(name),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesRoleBindingDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubeProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_role_binding" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.RbacV1beta1().RoleBindings(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Role binding still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesRoleBindingExists(n string, obj *api.RoleBinding) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubeProvider).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.RbacV1beta1().RoleBindings(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesRoleBindingConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_service_account" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+
+resource "kubernetes_role" "test" {
+  metadata {
+    name = "%s"
+  }
+  rule {
+    api_groups = [""]
+    resources  = ["pods"]
+    verbs      = ["get", "list"]
+  }
+}
+
+resource "kubernetes_role_binding" "test" {
+  metadata {
+    name = "%s"
+  }
+  role_ref {
+    kind = "Role"
+    name = "${kubernetes_role.test.metadata.0.name}"
+  }
+  subject {
+    kind      = "ServiceAccount"
+    name      = "${kubernetes_service_account.test.metadata.0.name}"
+    namespace = "default"
+  }
+}
+`, name, name, name)
+}
+
+func testAccKubernetesRoleBindingConfig_modified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_service_account" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+
+resource "kubernetes_role" "test" {
+  metadata {
+    name = "%s"
+  }
+  rule {
+    api_groups = [""]
+    resources  = ["pods"]
+    verbs      = ["get", "list"]
+  }
+}
+
+resource "kubernetes_role_binding" "test" {
+  metadata {
+    name = "%s"
+  }
+  role_ref {
+    kind = "Role"
+    name = "${kubernetes_role.test.metadata.0.name}"
+  }
+  subject {
+    kind      = "ServiceAccount"
+    name      = "${kubernetes_service_account.test.metadata.0.name}"
+    namespace = "default"
+  }
+  subject {
+    kind = "User"
+    name = "jane"
+  }
+}
+`, name, name, name)
+}
@@ -0,0 +1,167 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/kubernetes/pkg/apis/rbac/v1beta1"
+)
+
+func TestAccKubernetesRole_basic(t *testing.T) {
+	var conf api.Role
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_role.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesRoleConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRoleExists("kubernetes_role.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_role.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_role.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_role.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_role.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "rule.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "rule.0.api_groups.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "rule.0.resources.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "rule.0.verbs.#", "3"),
+				),
+			},
+			{
+				Config: testAccKubernetesRoleConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRoleExists("kubernetes_role.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "rule.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "rule.1.api_groups.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "rule.1.resources.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "rule.1.resource_names.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_role.test", "rule.1.verbs.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesRole_importBasic(t *testing.T) {
+	resourceName := "kubernetes_role.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesRoleConfig_basic(name),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesRoleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubeProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_role" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.RbacV1beta1().Roles(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Role still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesRoleExists(n string, obj *api.Role) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubeProvider).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.RbacV1beta1().Roles(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesRoleConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_role" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    name = "%s"
+  }
+  rule {
+    api_groups = [""]
+    resources  = ["pods"]
+    verbs      = ["get", "list", "watch"]
+  }
+}
+`, name)
+}
+
+func testAccKubernetesRoleConfig_modified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_role" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    name = "%s"
+  }
+  rule {
+    api_groups = [""]
+    resources  = ["pods"]
+    verbs      = ["get", "list", "watch"]
+  }
+  rule {
+    api_groups     = [""]
+    resources      = ["configmaps"]
+    resource_names = ["app-config"]
+    verbs          = ["get"]
+  }
+}
+`, name)
+}
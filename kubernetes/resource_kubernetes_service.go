@@ -11,7 +11,6 @@ import (
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	api "k8s.io/kubernetes/pkg/api/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 func resourceKubernetesService() *schema.Resource {
@@ -22,7 +21,7 @@ func resourceKubernetesService() *schema.Resource {
 		Update: resourceKubernetesServiceUpdate,
 		Delete: resourceKubernetesServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importNamespacedState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,11 +34,12 @@ func resourceKubernetesService() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"cluster_ip": {
-							Type:        schema.TypeString,
-							Description: "The IP address of the service. It is usually assigned randomly by the master. If an address is specified manually and is not in use by others, it will be allocated to the service; otherwise, creation of the service will fail. `None` can be specified for headless services when proxying is not required. Ignored if type is `ExternalName`. More info: http://kubernetes.io/docs/user-guide/services#virtual-ips-and-service-proxies",
-							Optional:    true,
-							ForceNew:    true,
-							Computed:    true,
+							Type:         schema.TypeString,
+							Description:  "The IP address of the service. It is usually assigned randomly by the master. If an address is specified manually and is not in use by others, it will be allocated to the service; otherwise, creation of the service will fail. `None` can be specified for headless services when proxying is not required. Ignored if type is `ExternalName`. More info: http://kubernetes.io/docs/user-guide/services#virtual-ips-and-service-proxies",
+							Optional:     true,
+							ForceNew:     true,
+							Computed:     true,
+							ValidateFunc: validateClusterIP,
 						},
 						"external_ips": {
 							Type:        schema.TypeSet,
@@ -53,6 +53,20 @@ func resourceKubernetesService() *schema.Resource {
 							Description: "The external reference that kubedns or equivalent will return as a CNAME record for this service. No proxying will be involved. Must be a valid DNS name and requires `type` to be `ExternalName`.",
 							Optional:    true,
 						},
+						"external_traffic_policy": {
+							Type:         schema.TypeString,
+							Description:  "Denotes if this service desires to route external traffic to node-local or cluster-wide endpoints. `Local` preserves the client source IP and avoids a second hop for `LoadBalancer` and `NodePort` type services, but risks potentially imbalanced traffic spreading. `Cluster` obscures the client source IP and may cause a second hop to another node, but should have good overall load-spreading. Stored as the `service.beta.kubernetes.io/external-traffic` annotation, which only takes effect on clusters still honouring it. More info: https://kubernetes.io/docs/tutorials/services/source-ip/",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateAttributeValueIsIn([]string{"Local", "Cluster"}),
+						},
+						"health_check_node_port": {
+							Type:         schema.TypeInt,
+							Description:  "The node port used by the cloud load balancer to health check the service. Only applies to `type = LoadBalancer` with `external_traffic_policy = \"Local\"`. Allocated by the system if not specified. Stored as the `service.beta.kubernetes.io/healthcheck-nodeport` annotation, which only takes effect on clusters still honouring it.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validatePortNum,
+						},
 						"load_balancer_ip": {
 							Type:        schema.TypeString,
 							Description: "Only applies to `type = LoadBalancer`. LoadBalancer will get created with the IP specified in this field. This feature depends on whether the underlying cloud-provider supports specifying this field when a load balancer is created. This field will be ignored if the cloud-provider does not support the feature.",
@@ -95,14 +109,21 @@ func resourceKubernetesService() *schema.Resource {
 										Default:     "TCP",
 									},
 									"target_port": {
-										Type:        schema.TypeInt,
-										Description: "Number or name of the port to access on the pods targeted by the service. Number must be in the range 1 to 65535. This field is ignored for services with `cluster_ip = \"None\"`. More info: http://kubernetes.io/docs/user-guide/services#defining-a-service",
-										Optional:    true,
-										Computed:    true,
+										Type:         schema.TypeString,
+										Description:  "Number or name of the port to access on the pods targeted by the service. Number must be in the range 1 to 65535. Name must match a named port in the pods' containers. This field is ignored for services with `cluster_ip = \"None\"`. More info: http://kubernetes.io/docs/user-guide/services#defining-a-service",
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validatePortNumOrName,
 									},
 								},
 							},
 						},
+						"publish_not_ready_addresses": {
+							Type:        schema.TypeBool,
+							Description: "When set to true, endpoints are published for pods that are not ready, e.g. so that the members of a stateful set behind a headless service can discover each other before they become ready. Stored as the `service.alpha.kubernetes.io/tolerate-unready-endpoints` annotation, which only takes effect on clusters still honouring it.",
+							Optional:    true,
+							Default:     false,
+						},
 						"selector": {
 							Type:        schema.TypeMap,
 							Description: "Route service traffic to pods with label keys and values matching this selector. Only applies to types `ClusterIP`, `NodePort`, and `LoadBalancer`. More info: http://kubernetes.io/docs/user-guide/services#overview",
@@ -144,9 +165,12 @@ func resourceKubernetesService() *schema.Resource {
 }
 
 func resourceKubernetesServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandNamespacedMetadata(d, meta)
+	for k, v := range expandServiceSpecAnnotations(d.Get("spec").([]interface{})) {
+		metadata.Annotations[k] = v
+	}
 	svc := api.Service{
 		ObjectMeta: metadata,
 		Spec:       expandServiceSpec(d.Get("spec").([]interface{})),
@@ -192,7 +216,7 @@ func resourceKubernetesServiceCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesServiceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -206,19 +230,21 @@ func resourceKubernetesServiceRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 	log.Printf("[INFO] Received service: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta))
+	// Spec has to be flattened first as some of its fields are read
+	// from annotations which flattenMetadata strips
+	flattened := flattenServiceSpec(svc.Spec, svc.Annotations)
+	log.Printf("[DEBUG] Flattened service spec: %#v", flattened)
+	err = d.Set("spec", flattened)
 	if err != nil {
 		return err
 	}
 
-	err = d.Set("load_balancer_ingress", flattenLoadBalancerIngress(svc.Status.LoadBalancer.Ingress))
+	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d))
 	if err != nil {
 		return err
 	}
 
-	flattened := flattenServiceSpec(svc.Spec)
-	log.Printf("[DEBUG] Flattened service spec: %#v", flattened)
-	err = d.Set("spec", flattened)
+	err = d.Set("load_balancer_ingress", flattenLoadBalancerIngress(svc.Status.LoadBalancer.Ingress))
 	if err != nil {
 		return err
 	}
@@ -227,24 +253,29 @@ func resourceKubernetesServiceRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceKubernetesServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	if d.HasChange("spec") {
-		diffOps := patchServiceSpec("spec.0.", "/spec/", d)
-		ops = append(ops, diffOps...)
-	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating service %q: %v", name, string(data))
-	out, err := conn.CoreV1().Services(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	var out *api.Service
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		if d.HasChange("spec") {
+			diffOps, err := patchServiceSpec("spec.0.", "/spec/", d)
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, diffOps...)
+		}
+		return ops, nil
+	}, resourceKubernetesServiceRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating service %q: %v", name, string(data))
+		out, err = conn.CoreV1().Services(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update service: %s", err)
 	}
@@ -255,7 +286,7 @@ func resourceKubernetesServiceUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -275,7 +306,7 @@ func resourceKubernetesServiceDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesServiceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
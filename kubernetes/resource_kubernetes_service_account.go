@@ -11,7 +11,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	api "k8s.io/kubernetes/pkg/api/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 func resourceKubernetesServiceAccount() *schema.Resource {
@@ -65,9 +64,9 @@ func resourceKubernetesServiceAccount() *schema.Resource {
 }
 
 func resourceKubernetesServiceAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandNamespacedMetadata(d, meta)
 	svcAcc := api.ServiceAccount{
 		AutomountServiceAccountToken: ptrToBool(false),
 		ObjectMeta:                   metadata,
@@ -126,7 +125,7 @@ func diffObjectReferences(origOrs []api.ObjectReference, ors []api.ObjectReferen
 }
 
 func resourceKubernetesServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -140,7 +139,7 @@ func resourceKubernetesServiceAccountRead(d *schema.ResourceData, meta interface
 		return err
 	}
 	log.Printf("[INFO] Received service account: %#v", svcAcc)
-	err = d.Set("metadata", flattenMetadata(svcAcc.ObjectMeta))
+	err = d.Set("metadata", flattenMetadata(svcAcc.ObjectMeta, d))
 	if err != nil {
 		return err
 	}
@@ -156,36 +155,38 @@ func resourceKubernetesServiceAccountRead(d *schema.ResourceData, meta interface
 }
 
 func resourceKubernetesServiceAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	if d.HasChange("image_pull_secret") {
-		v := d.Get("image_pull_secret").(*schema.Set).List()
-		ops = append(ops, &ReplaceOperation{
-			Path:  "/imagePullSecrets",
-			Value: expandLocalObjectReferenceArray(v),
-		})
-	}
-	if d.HasChange("secret") {
-		v := d.Get("secret").(*schema.Set).List()
-		defaultSecretName := d.Get("default_secret_name").(string)
-
-		ops = append(ops, &ReplaceOperation{
-			Path:  "/secrets",
-			Value: expandServiceAccountSecrets(v, defaultSecretName),
-		})
-	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating service account %q: %v", name, string(data))
-	out, err := conn.CoreV1().ServiceAccounts(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	var out *api.ServiceAccount
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		if d.HasChange("image_pull_secret") {
+			v := d.Get("image_pull_secret").(*schema.Set).List()
+			ops = append(ops, &ReplaceOperation{
+				Path:  "/imagePullSecrets",
+				Value: expandLocalObjectReferenceArray(v),
+			})
+		}
+		if d.HasChange("secret") {
+			v := d.Get("secret").(*schema.Set).List()
+			defaultSecretName := d.Get("default_secret_name").(string)
+
+			ops = append(ops, &ReplaceOperation{
+				Path:  "/secrets",
+				Value: expandServiceAccountSecrets(v, defaultSecretName),
+			})
+		}
+		return ops, nil
+	}, resourceKubernetesServiceAccountRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating service account %q: %v", name, string(data))
+		out, err = conn.CoreV1().ServiceAccounts(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update service account: %s", err)
 	}
@@ -196,7 +197,7 @@ func resourceKubernetesServiceAccountUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceKubernetesServiceAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
@@ -216,7 +217,7 @@ func resourceKubernetesServiceAccountDelete(d *schema.ResourceData, meta interfa
 }
 
 func resourceKubernetesServiceAccountExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
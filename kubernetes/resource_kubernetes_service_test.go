@@ -12,7 +12,6 @@ import (
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	api "k8s.io/kubernetes/pkg/api/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 func TestAccKubernetesService_basic(t *testing.T) {
@@ -306,6 +305,80 @@ func TestAccKubernetesService_externalName(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesService_headless(t *testing.T) {
+	var conf api.Service
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_service.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesServiceConfig_headless(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists("kubernetes_service.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "metadata.0.annotations.%", "0"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.cluster_ip", "None"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.port.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.port.0.port", "7000"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.port.0.target_port", "intra-node"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.publish_not_ready_addresses", "true"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.type", "ClusterIP"),
+					testAccCheckServicePorts(&conf, []api.ServicePort{
+						{
+							Port:       int32(7000),
+							Protocol:   api.ProtocolTCP,
+							TargetPort: intstr.FromString("intra-node"),
+						},
+					}),
+				),
+			},
+			{
+				Config: testAccKubernetesServiceConfig_headless(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists("kubernetes_service.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.cluster_ip", "None"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.publish_not_ready_addresses", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesService_externalTrafficPolicy(t *testing.T) {
+	var conf api.Service
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t); skipIfNoLoadBalancersAvailable(t) },
+		IDRefreshName: "kubernetes_service.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesServiceConfig_externalTrafficPolicy(name, "Local"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists("kubernetes_service.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.external_traffic_policy", "Local"),
+					resource.TestCheckResourceAttrSet("kubernetes_service.test", "spec.0.health_check_node_port"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.type", "LoadBalancer"),
+				),
+			},
+			{
+				Config: testAccKubernetesServiceConfig_externalTrafficPolicy(name, "Cluster"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists("kubernetes_service.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "spec.0.external_traffic_policy", "Cluster"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesService_importBasic(t *testing.T) {
 	resourceName := "kubernetes_service.test"
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -403,7 +476,7 @@ func testAccCheckServicePorts(svc *api.Service, expected []api.ServicePort) reso
 }
 
 func testAccCheckKubernetesServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*kubernetes.Clientset)
+	conn := testAccProvider.Meta().(*kubeProvider).conn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "kubernetes_service" {
@@ -433,7 +506,7 @@ func testAccCheckKubernetesServiceExists(n string, obj *api.Service) resource.Te
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*kubernetes.Clientset)
+		conn := testAccProvider.Meta().(*kubeProvider).conn
 
 		namespace, name, err := idParts(rs.Primary.ID)
 		if err != nil {
@@ -611,6 +684,48 @@ resource "kubernetes_service" "test" {
 `, name)
 }
 
+func testAccKubernetesServiceConfig_headless(name string, publishNotReady bool) string {
+	return fmt.Sprintf(`
+resource "kubernetes_service" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		cluster_ip = "None"
+		publish_not_ready_addresses = %t
+		selector {
+			App = "cassandra"
+		}
+		port {
+			port = 7000
+			target_port = "intra-node"
+		}
+	}
+}
+`, name, publishNotReady)
+}
+
+func testAccKubernetesServiceConfig_externalTrafficPolicy(name, policy string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_service" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		external_traffic_policy = "%s"
+		selector {
+			App = "MyApp"
+		}
+		port {
+			port = 8888
+			target_port = 80
+		}
+		type = "LoadBalancer"
+	}
+}
+`, name, policy)
+}
+
 func testAccKubernetesServiceConfig_generatedName(prefix string) string {
 	return fmt.Sprintf(`
 resource "kubernetes_service" "test" {
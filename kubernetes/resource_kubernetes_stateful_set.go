@@ -0,0 +1,381 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	api "k8s.io/kubernetes/pkg/api/v1"
+	apps_v1beta1 "k8s.io/kubernetes/pkg/apis/apps/v1beta1"
+	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
+)
+
+func resourceKubernetesStatefulSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesStatefulSetCreate,
+		Read:   resourceKubernetesStatefulSetRead,
+		Exists: resourceKubernetesStatefulSetExists,
+		Update: resourceKubernetesStatefulSetUpdate,
+		Delete: resourceKubernetesStatefulSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: importNamespacedState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("stateful set", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the desired identities of pods in this set.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"replicas": {
+							Type:        schema.TypeInt,
+							Description: "The desired number of replicas of the given template. Each replica has a consistent identity. Defaults to 1.",
+							Optional:    true,
+							Default:     1,
+						},
+						"selector": {
+							Type:        schema.TypeList,
+							Description: "A label query over pods that should match the replica count. If empty, defaulted to labels on the pod template. More info: http://kubernetes.io/docs/user-guide/labels#label-selectors",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: generateLabelSelector(false),
+							},
+						},
+						"service_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the service that governs this stateful set. Pods get DNS/hostnames that follow the pattern: pod-specific-string.serviceName.default.svc.cluster.local",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"template": {
+							Type:        schema.TypeList,
+							Description: "Describes the pod that will be created if insufficient replicas are detected. Each pod stamped out by the stateful set will fulfill this template, but have a unique identity from the rest of the stateful set.",
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: podTemplateFields(true),
+							},
+						},
+						"volume_claim_template": {
+							Type:        schema.TypeList,
+							Description: "A list of claims that pods are allowed to reference. Every claim must have at least one matching (by name) volume mount in one container in the template. A claim takes precedence over any volumes in the template with the same name.",
+							Optional:    true,
+							ForceNew:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metadata": templateMetadataSchema("persistent volume claim"),
+									"spec": {
+										Type:        schema.TypeList,
+										Description: "Spec defines the desired characteristics of a volume requested by a pod author. More info: http://kubernetes.io/docs/user-guide/persistent-volumes#persistentvolumeclaims",
+										Required:    true,
+										ForceNew:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: persistentVolumeClaimSpecFields(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesStatefulSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	metadata := expandNamespacedMetadata(d, meta)
+	spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	spec.Template.Spec.AutomountServiceAccountToken = ptrToBool(false)
+
+	statefulSet := apps_v1beta1.StatefulSet{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}
+
+	log.Printf("[INFO] Creating new stateful set: %#v", statefulSet)
+	out, err := conn.AppsV1beta1().StatefulSets(metadata.Namespace).Create(&statefulSet)
+	if err != nil {
+		return fmt.Errorf("Failed to create stateful set: %s", err)
+	}
+
+	d.SetId(buildId(out.ObjectMeta))
+
+	log.Printf("[DEBUG] Waiting for all pods of stateful set %s to become ready", d.Id())
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate),
+		waitForStatefulSetReplicasFunc(conn, out.GetNamespace(), out.GetName()))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted new stateful set: %#v", out)
+
+	return resourceKubernetesStatefulSetRead(d, meta)
+}
+
+func resourceKubernetesStatefulSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading stateful set %s", name)
+	statefulSet, err := conn.AppsV1beta1().StatefulSets(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received stateful set: %#v", statefulSet)
+
+	err = d.Set("metadata", flattenMetadata(statefulSet.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	spec, err := flattenStatefulSetSpec(statefulSet.Spec)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("spec", spec)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesStatefulSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var out *apps_v1beta1.StatefulSet
+	err = patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		if d.HasChange("spec") {
+			diffOps, err := patchStatefulSetSpec("spec.0.", "/spec", d)
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, diffOps...)
+		}
+		return ops, nil
+	}, resourceKubernetesStatefulSetRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating stateful set %q: %v", name, string(data))
+		out, err = conn.AppsV1beta1().StatefulSets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update stateful set: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated stateful set: %#v", out)
+
+	err = resource.Retry(d.Timeout(schema.TimeoutUpdate),
+		waitForStatefulSetReplicasFunc(conn, namespace, name))
+	if err != nil {
+		return err
+	}
+
+	return resourceKubernetesStatefulSetRead(d, meta)
+}
+
+func resourceKubernetesStatefulSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting stateful set: %#v", name)
+
+	// Scale down to zero first, so pods are terminated in reverse ordinal order
+	var ops PatchOperations
+	ops = append(ops, &ReplaceOperation{
+		Path:  "/spec/replicas",
+		Value: 0,
+	})
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	_, err = conn.AppsV1beta1().StatefulSets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	if err != nil {
+		return err
+	}
+
+	// Wait until all replicas are gone
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete),
+		waitForStatefulSetReplicasFunc(conn, namespace, name))
+	if err != nil {
+		return err
+	}
+
+	err = conn.AppsV1beta1().StatefulSets(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Stateful set %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesStatefulSetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubeProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking stateful set %s", name)
+	_, err = conn.AppsV1beta1().StatefulSets(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+// waitForStatefulSetReplicasFunc checks each ordinal pod separately
+// as the stateful set status does not report the number of ready replicas.
+// Where the API server rolls out template changes itself, it also waits
+// for every pod to run the latest revision of the template.
+func waitForStatefulSetReplicasFunc(conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
+	return func() *resource.RetryError {
+		statefulSet, revisions, err := getStatefulSetWithRevisions(conn, ns, name)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		observedGeneration := statefulSet.Status.ObservedGeneration
+		if observedGeneration == nil || statefulSet.Generation > *observedGeneration {
+			return resource.RetryableError(fmt.Errorf("Waiting for stateful set %q to be observed by the controller", name))
+		}
+
+		desiredReplicas := int32(1)
+		if statefulSet.Spec.Replicas != nil {
+			desiredReplicas = *statefulSet.Spec.Replicas
+		}
+		log.Printf("[DEBUG] Current number of replicas of %q: %d (of %d)\n",
+			name, statefulSet.Status.Replicas, desiredReplicas)
+
+		for i := int32(0); i < desiredReplicas; i++ {
+			podName := fmt.Sprintf("%s-%d", name, i)
+			pod, err := conn.CoreV1().Pods(ns).Get(podName, metav1.GetOptions{})
+			if err != nil {
+				if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+					return resource.RetryableError(fmt.Errorf("Waiting for pod %q to be created", podName))
+				}
+				return resource.NonRetryableError(err)
+			}
+			if revisions.podNeedsUpdate(pod, i) {
+				return resource.RetryableError(fmt.Errorf("Waiting for pod %q to be updated to revision %q",
+					podName, revisions.Status.UpdateRevision))
+			}
+			if !isPodReady(pod) {
+				return resource.RetryableError(fmt.Errorf("Waiting for pod %q to become ready", podName))
+			}
+		}
+
+		if statefulSet.Status.Replicas != desiredReplicas {
+			return resource.RetryableError(fmt.Errorf("Waiting for %d replicas of %q to be scheduled (%d)",
+				desiredReplicas, name, statefulSet.Status.Replicas))
+		}
+
+		return nil
+	}
+}
+
+// statefulSetRevisions holds the fields describing the rollout of the
+// template of a stateful set, which the vendored API types predate
+type statefulSetRevisions struct {
+	Spec struct {
+		UpdateStrategy struct {
+			Type          string `json:"type"`
+			RollingUpdate *struct {
+				Partition *int32 `json:"partition"`
+			} `json:"rollingUpdate"`
+		} `json:"updateStrategy"`
+	} `json:"spec"`
+	Status struct {
+		CurrentRevision string `json:"currentRevision"`
+		UpdateRevision  string `json:"updateRevision"`
+	} `json:"status"`
+}
+
+// podNeedsUpdate tells whether the pod with the given ordinal is yet to be
+// replaced by one running the update revision. Servers which don't report
+// revisions, or don't replace pods themselves, never update existing pods.
+func (r *statefulSetRevisions) podNeedsUpdate(pod *api.Pod, ordinal int32) bool {
+	if r.Status.UpdateRevision == "" || r.Spec.UpdateStrategy.Type != "RollingUpdate" {
+		return false
+	}
+	if ru := r.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil && ordinal < *ru.Partition {
+		return false
+	}
+	return pod.Labels["controller-revision-hash"] != r.Status.UpdateRevision
+}
+
+// getStatefulSetWithRevisions reads the stateful set along with the
+// revisions of its template, which the typed client would drop
+func getStatefulSetWithRevisions(conn *kubernetes.Clientset, ns, name string) (*apps_v1beta1.StatefulSet, *statefulSetRevisions, error) {
+	raw, err := conn.AppsV1beta1().RESTClient().Get().
+		Namespace(ns).Resource("statefulsets").Name(name).Do().Raw()
+	if err != nil {
+		return nil, nil, err
+	}
+	statefulSet := &apps_v1beta1.StatefulSet{}
+	if err := json.Unmarshal(raw, statefulSet); err != nil {
+		return nil, nil, err
+	}
+	revisions := &statefulSetRevisions{}
+	if err := json.Unmarshal(raw, revisions); err != nil {
+		return nil, nil, err
+	}
+	return statefulSet, revisions, nil
+}
+
+func isPodReady(pod *api.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == api.PodReady {
+			return c.Status == api.ConditionTrue
+		}
+	}
+	return false
+}
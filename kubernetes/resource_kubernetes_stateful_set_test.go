@@ -0,0 +1,291 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apps_v1beta1 "k8s.io/kubernetes/pkg/apis/apps/v1beta1"
+)
+
+func TestAccKubernetesStatefulSet_basic(t *testing.T) {
+	var conf apps_v1beta1.StatefulSet
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_stateful_set.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesStatefulSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesStatefulSetConfig_basic(name, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStatefulSetExists("kubernetes_stateful_set.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "metadata.0.labels.TestLabelOne", "one"),
+					testAccCheckMetaLabels(&conf.ObjectMeta, map[string]string{"TestLabelOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_stateful_set.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_stateful_set.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_stateful_set.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_stateful_set.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "spec.0.replicas", "2"),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "spec.0.service_name", name),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "spec.0.template.0.spec.0.container.0.image", "nginx:1.7.9"),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "spec.0.template.0.spec.0.container.0.name", "tf-acc-test"),
+				),
+			},
+			{
+				Config: testAccKubernetesStatefulSetConfig_basic(name, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStatefulSetExists("kubernetes_stateful_set.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "spec.0.replicas", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesStatefulSet_importBasic(t *testing.T) {
+	resourceName := "kubernetes_stateful_set.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesStatefulSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesStatefulSetConfig_basic(name, 1),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKubernetesStatefulSet_generatedName(t *testing.T) {
+	var conf apps_v1beta1.StatefulSet
+	prefix := "tf-acc-test-gen-"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_stateful_set.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesStatefulSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesStatefulSetConfig_generatedName(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStatefulSetExists("kubernetes_stateful_set.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "metadata.0.generate_name", prefix),
+					resource.TestMatchResourceAttr("kubernetes_stateful_set.test", "metadata.0.name", regexp.MustCompile("^"+prefix)),
+					resource.TestCheckResourceAttrSet("kubernetes_stateful_set.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_stateful_set.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_stateful_set.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_stateful_set.test", "metadata.0.uid"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesStatefulSet_with_volume_claim_template(t *testing.T) {
+	var conf apps_v1beta1.StatefulSet
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_stateful_set.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesStatefulSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesStatefulSetConfigWithVolumeClaimTemplate(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStatefulSetExists("kubernetes_stateful_set.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "spec.0.volume_claim_template.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "spec.0.volume_claim_template.0.metadata.0.name", "data"),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "spec.0.volume_claim_template.0.spec.0.access_modes.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "spec.0.volume_claim_template.0.spec.0.resources.0.requests.storage", "1Gi"),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "spec.0.template.0.spec.0.container.0.volume_mount.0.name", "data"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesStatefulSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubeProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_stateful_set" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.AppsV1beta1().StatefulSets(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Stateful Set still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesStatefulSetExists(n string, obj *apps_v1beta1.StatefulSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubeProvider).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.AppsV1beta1().StatefulSets(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesStatefulSetConfig_basic(name string, replicas int) string {
+	return fmt.Sprintf(`
+resource "kubernetes_service" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    cluster_ip = "None"
+    selector {
+      TestLabelOne = "one"
+    }
+    port {
+      port = 80
+    }
+  }
+}
+
+resource "kubernetes_stateful_set" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    labels {
+      TestLabelOne = "one"
+    }
+    name = "%s"
+  }
+  spec {
+    replicas     = %d
+    service_name = "${kubernetes_service.test.metadata.0.name}"
+    template {
+      metadata {
+        labels {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image = "nginx:1.7.9"
+          name  = "tf-acc-test"
+        }
+      }
+    }
+  }
+}
+`, name, name, replicas)
+}
+
+func testAccKubernetesStatefulSetConfig_generatedName(prefix string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_stateful_set" "test" {
+  metadata {
+    generate_name = "%s"
+  }
+  spec {
+    service_name = "tf-acc-test"
+    template {
+      metadata {
+        labels {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image = "nginx:1.7.9"
+          name  = "tf-acc-test"
+        }
+      }
+    }
+  }
+}`, prefix)
+}
+
+func testAccKubernetesStatefulSetConfigWithVolumeClaimTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_stateful_set" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    service_name = "tf-acc-test"
+    template {
+      metadata {
+        labels {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image = "nginx:1.7.9"
+          name  = "tf-acc-test"
+          volume_mount {
+            mount_path = "/usr/share/nginx/html"
+            name       = "data"
+          }
+        }
+      }
+    }
+    volume_claim_template {
+      metadata {
+        name = "data"
+      }
+      spec {
+        access_modes = ["ReadWriteOnce"]
+        resources {
+          requests {
+            storage = "1Gi"
+          }
+        }
+      }
+    }
+  }
+}`, name)
+}
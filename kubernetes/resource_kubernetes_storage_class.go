@@ -9,7 +9,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	api "k8s.io/kubernetes/pkg/apis/storage/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 func resourceKubernetesStorageClass() *schema.Resource {
@@ -42,7 +41,7 @@ func resourceKubernetesStorageClass() *schema.Resource {
 }
 
 func resourceKubernetesStorageClassCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	metadata := expandMetadata(d.Get("metadata").([]interface{}))
 	storageClass := api.StorageClass{
@@ -66,7 +65,7 @@ func resourceKubernetesStorageClassCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceKubernetesStorageClassRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Reading storage class %s", name)
@@ -76,7 +75,7 @@ func resourceKubernetesStorageClassRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 	log.Printf("[INFO] Received storage class: %#v", storageClass)
-	err = d.Set("metadata", flattenMetadata(storageClass.ObjectMeta))
+	err = d.Set("metadata", flattenMetadata(storageClass.ObjectMeta, d))
 	if err != nil {
 		return err
 	}
@@ -87,16 +86,18 @@ func resourceKubernetesStorageClassRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceKubernetesStorageClassUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	name := d.Id()
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating storage class %q: %v", name, string(data))
-	out, err := conn.StorageV1().StorageClasses().Patch(name, pkgApi.JSONPatchType, data)
+	var out *api.StorageClass
+	err := patchResource(d, meta, func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		return ops, nil
+	}, resourceKubernetesStorageClassRead, func(data []byte) (err error) {
+		log.Printf("[INFO] Updating storage class %q: %v", name, string(data))
+		out, err = conn.StorageV1().StorageClasses().Patch(name, pkgApi.JSONPatchType, data)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Failed to update storage class: %s", err)
 	}
@@ -107,7 +108,7 @@ func resourceKubernetesStorageClassUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceKubernetesStorageClassDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Deleting storage class: %#v", name)
@@ -123,7 +124,7 @@ func resourceKubernetesStorageClassDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceKubernetesStorageClassExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*kubernetes.Clientset)
+	conn := meta.(*kubeProvider).conn
 
 	name := d.Id()
 	log.Printf("[INFO] Checking storage class %s", name)
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	api "k8s.io/kubernetes/pkg/apis/storage/v1"
-	kubernetes "k8s.io/kubernetes/pkg/client/clientset_generated/clientset"
 )
 
 func TestAccKubernetesStorageClass_basic(t *testing.T) {
@@ -182,7 +181,7 @@ func testAccCheckStorageClassParameters(m *api.StorageClass, expected map[string
 }
 
 func testAccCheckKubernetesStorageClassDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*kubernetes.Clientset)
+	conn := testAccProvider.Meta().(*kubeProvider).conn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "kubernetes_storage_class" {
@@ -207,7 +206,7 @@ func testAccCheckKubernetesStorageClassExists(n string, obj *api.StorageClass) r
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*kubernetes.Clientset)
+		conn := testAccProvider.Meta().(*kubeProvider).conn
 		name := rs.Primary.ID
 		out, err := conn.StorageV1().StorageClasses().Get(name, meta_v1.GetOptions{})
 		if err != nil {
@@ -0,0 +1,198 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func affinityFields(isUpdatable bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"node_affinity": {
+			Type:        schema.TypeList,
+			Description: "Node affinity scheduling rules for the pod.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: nodeAffinityFields(isUpdatable),
+			},
+		},
+		"pod_affinity": {
+			Type:        schema.TypeList,
+			Description: "Inter-pod affinity scheduling rules, e.g. co-locate this pod in the same node, zone, etc. as some other pod(s).",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: podAffinityFields(isUpdatable),
+			},
+		},
+		"pod_anti_affinity": {
+			Type:        schema.TypeList,
+			Description: "Inter-pod anti-affinity scheduling rules, e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s).",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: podAffinityFields(isUpdatable),
+			},
+		},
+	}
+}
+
+func nodeAffinityFields(isUpdatable bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"preferred_during_scheduling_ignored_during_execution": {
+			Type:        schema.TypeList,
+			Description: "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"preference": {
+						Type:        schema.TypeList,
+						Description: "A node selector term, associated with the corresponding weight.",
+						Required:    true,
+						ForceNew:    !isUpdatable,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: nodeSelectorTermFields(isUpdatable),
+						},
+					},
+					"weight": {
+						Type:         schema.TypeInt,
+						Description:  "Weight associated with matching the corresponding node selector term, in the range 1-100.",
+						Required:     true,
+						ForceNew:     !isUpdatable,
+						ValidateFunc: validateAffinityWeight,
+					},
+				},
+			},
+		},
+		"required_during_scheduling_ignored_during_execution": {
+			Type:        schema.TypeList,
+			Description: "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"node_selector_term": {
+						Type:        schema.TypeList,
+						Description: "List of node selector terms. The terms are ORed.",
+						Required:    true,
+						ForceNew:    !isUpdatable,
+						Elem: &schema.Resource{
+							Schema: nodeSelectorTermFields(isUpdatable),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func nodeSelectorTermFields(isUpdatable bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"match_expressions": {
+			Type:        schema.TypeList,
+			Description: "A list of node selector requirements by node's labels. The requirements are ANDed.",
+			Required:    true,
+			ForceNew:    !isUpdatable,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:        schema.TypeString,
+						Description: "The label key that the selector applies to.",
+						Required:    true,
+						ForceNew:    !isUpdatable,
+					},
+					"operator": {
+						Type:         schema.TypeString,
+						Description:  "A key's relationship to a set of values. Valid operators are `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt` and `Lt`.",
+						Required:     true,
+						ForceNew:     !isUpdatable,
+						ValidateFunc: validateAttributeValueIsIn([]string{"In", "NotIn", "Exists", "DoesNotExist", "Gt", "Lt"}),
+					},
+					"values": {
+						Type:        schema.TypeSet,
+						Description: "An array of string values. If the operator is `In` or `NotIn`, the values array must be non-empty. If the operator is `Exists` or `DoesNotExist`, the values array must be empty. If the operator is `Gt` or `Lt`, the values array must have a single element, which will be interpreted as an integer.",
+						Optional:    true,
+						ForceNew:    !isUpdatable,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Set:         schema.HashString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func podAffinityFields(isUpdatable bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"preferred_during_scheduling_ignored_during_execution": {
+			Type:        schema.TypeList,
+			Description: "The scheduler will prefer to schedule pods to nodes that satisfy the expressions specified by this field, but it may choose a node that violates one or more of the expressions.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"pod_affinity_term": {
+						Type:        schema.TypeList,
+						Description: "A pod affinity term, associated with the corresponding weight.",
+						Required:    true,
+						ForceNew:    !isUpdatable,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: podAffinityTermFields(isUpdatable),
+						},
+					},
+					"weight": {
+						Type:         schema.TypeInt,
+						Description:  "Weight associated with matching the corresponding pod affinity term, in the range 1-100.",
+						Required:     true,
+						ForceNew:     !isUpdatable,
+						ValidateFunc: validateAffinityWeight,
+					},
+				},
+			},
+		},
+		"required_during_scheduling_ignored_during_execution": {
+			Type:        schema.TypeList,
+			Description: "If the requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Elem: &schema.Resource{
+				Schema: podAffinityTermFields(isUpdatable),
+			},
+		},
+	}
+}
+
+func podAffinityTermFields(isUpdatable bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"label_selector": {
+			Type:        schema.TypeList,
+			Description: "A label query over a set of pods.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: generateLabelSelector(isUpdatable),
+			},
+		},
+		"namespaces": {
+			Type:        schema.TypeSet,
+			Description: "The namespaces the label selector applies to. Defaults to the namespace of the pod.",
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+		},
+		"topology_key": {
+			Type:        schema.TypeString,
+			Description: "The node label whose value determines co-location: the pod is (anti-)affine to nodes with the same value for this label as nodes running a matching pod, e.g. `kubernetes.io/hostname` or `failure-domain.beta.kubernetes.io/zone`.",
+			Required:    true,
+			ForceNew:    !isUpdatable,
+		},
+	}
+}
@@ -318,6 +318,39 @@ func containerFields(isUpdatable bool) map[string]*schema.Schema {
 				},
 			},
 		},
+		"env_from": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "List of sources to populate environment variables in the container. The keys defined within a source must be a C_IDENTIFIER. All invalid keys will be reported as an event when the container is starting. When a key exists in multiple sources, the value associated with the last source will take precedence. Values defined by an `env` with a duplicate key will take precedence. Cannot be updated.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"config_map_ref": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "The ConfigMap to select from",
+						Elem: &schema.Resource{
+							Schema: envFromSourceRefFields("ConfigMap"),
+						},
+					},
+					"prefix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "An optional identifer to prepend to each key in the ConfigMap. Must be a C_IDENTIFIER.",
+					},
+					"secret_ref": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "The Secret to select from",
+						Elem: &schema.Resource{
+							Schema: envFromSourceRefFields("Secret"),
+						},
+					},
+				},
+			},
+		},
 		"image": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -487,6 +520,21 @@ func containerFields(isUpdatable bool) map[string]*schema.Schema {
 	return s
 }
 
+func envFromSourceRefFields(kind string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+		},
+		"optional": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Specify whether the " + kind + " must be defined",
+		},
+	}
+}
+
 func probeSchema() *schema.Resource {
 	h := handlerFields()
 	h["failure_threshold"] = &schema.Schema{
@@ -590,3 +638,80 @@ func securityContextSchema() *schema.Resource {
 		Schema: m,
 	}
 }
+
+func containerStateFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"running": {
+			Type:        schema.TypeList,
+			Description: "Details about a running container.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"started_at": {
+						Type:        schema.TypeString,
+						Description: "RFC 3339 date and time at which the container was last (re-)started.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"terminated": {
+			Type:        schema.TypeList,
+			Description: "Details about a terminated container.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"exit_code": {
+						Type:        schema.TypeInt,
+						Description: "Exit status from the last termination of the container.",
+						Computed:    true,
+					},
+					"finished_at": {
+						Type:        schema.TypeString,
+						Description: "RFC 3339 date and time at which the container last terminated.",
+						Computed:    true,
+					},
+					"message": {
+						Type:        schema.TypeString,
+						Description: "Message regarding the last termination of the container.",
+						Computed:    true,
+					},
+					"reason": {
+						Type:        schema.TypeString,
+						Description: "Brief reason from the last termination of the container.",
+						Computed:    true,
+					},
+					"signal": {
+						Type:        schema.TypeInt,
+						Description: "Signal from the last termination of the container.",
+						Computed:    true,
+					},
+					"started_at": {
+						Type:        schema.TypeString,
+						Description: "RFC 3339 date and time at which the container's previous execution started.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"waiting": {
+			Type:        schema.TypeList,
+			Description: "Details about a waiting container.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"message": {
+						Type:        schema.TypeString,
+						Description: "Message regarding why the container is not yet running.",
+						Computed:    true,
+					},
+					"reason": {
+						Type:        schema.TypeString,
+						Description: "Brief reason the container is not yet running.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func cronJobSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"concurrency_policy": {
+			Type:         schema.TypeString,
+			Description:  "Specifies how to treat concurrent executions of a job. Defaults to Allow.",
+			Optional:     true,
+			Default:      "Allow",
+			ValidateFunc: validateAttributeValueIsIn([]string{"Allow", "Forbid", "Replace"}),
+		},
+		"failed_jobs_history_limit": {
+			Type:         schema.TypeInt,
+			Description:  "The number of failed finished jobs to retain. Defaults to 1.",
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validateNonNegativeInteger,
+		},
+		"job_template": {
+			Type:        schema.TypeList,
+			Description: "Describes the job that will be created when executing a cron job.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: jobTemplateFields(),
+			},
+		},
+		"schedule": {
+			Type:         schema.TypeString,
+			Description:  "The schedule in Cron format, see https://en.wikipedia.org/wiki/Cron.",
+			Required:     true,
+			ValidateFunc: validateCronExpression,
+		},
+		"starting_deadline_seconds": {
+			Type:         schema.TypeInt,
+			Description:  "Optional deadline in seconds for starting the job if it misses scheduled time for any reason. Missed jobs executions will be counted as failed ones.",
+			Optional:     true,
+			ValidateFunc: validatePositiveInteger,
+		},
+		"successful_jobs_history_limit": {
+			Type:         schema.TypeInt,
+			Description:  "The number of successful finished jobs to retain. Defaults to 3.",
+			Optional:     true,
+			Default:      3,
+			ValidateFunc: validateNonNegativeInteger,
+		},
+		"suspend": {
+			Type:        schema.TypeBool,
+			Description: "This flag tells the controller to suspend subsequent executions, it does not apply to already started executions. Defaults to false.",
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
+
+func jobTemplateFields() map[string]*schema.Schema {
+	metadata := templateMetadataSchema("job")
+	metadata.Required = false
+	metadata.Optional = true
+	metadata.Computed = true
+
+	return map[string]*schema.Schema{
+		"metadata": metadata,
+		"spec": {
+			Type:        schema.TypeList,
+			Description: "Specification of the desired behavior of the job.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: jobSpecFields(true),
+			},
+		},
+	}
+}
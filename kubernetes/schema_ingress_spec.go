@@ -0,0 +1,101 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func ingressSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeList,
+			Description: "A default backend capable of servicing requests that don't match any rule. At least one of `backend` or `rule` must be specified.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        ingressBackendSchema(),
+		},
+		"rule": {
+			Type:        schema.TypeList,
+			Description: "A list of host rules used to configure the ingress. If unspecified, or no rule matches, all traffic is sent to the default backend.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"host": {
+						Type:        schema.TypeString,
+						Description: "Fully qualified domain name of a network host, as defined by RFC 3986. IP addresses and ports are not allowed. If unspecified, the rule applies to all inbound HTTP traffic.",
+						Optional:    true,
+					},
+					"http": {
+						Type:        schema.TypeList,
+						Description: "HTTP rule, mapping paths to backends.",
+						Required:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"path": {
+									Type:        schema.TypeList,
+									Description: "A collection of paths that map requests to backends.",
+									Required:    true,
+									MinItems:    1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"backend": {
+												Type:        schema.TypeList,
+												Description: "Defines the referenced service endpoint to which the traffic will be forwarded.",
+												Required:    true,
+												MaxItems:    1,
+												Elem:        ingressBackendSchema(),
+											},
+											"path": {
+												Type:        schema.TypeString,
+												Description: "An extended POSIX regex matched against the path of an incoming request. Must begin with a '/'. If unspecified, the path defaults to a catch all sending traffic to the backend.",
+												Optional:    true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"tls": {
+			Type:        schema.TypeList,
+			Description: "TLS configuration. Currently the ingress only supports a single TLS port, 443. If multiple members of this list specify different hosts, they will be multiplexed on the same port according to the hostname specified through the SNI TLS extension.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hosts": {
+						Type:        schema.TypeList,
+						Description: "Hosts included in the TLS certificate. The values must match the names used in the `rule` blocks. Defaults to the wildcard host setting for the load balancer controller fulfilling this ingress.",
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"secret_name": {
+						Type:        schema.TypeString,
+						Description: "Name of the secret used to terminate TLS traffic on port 443. Optional to allow TLS routing based on SNI hostname alone.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func ingressBackendSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the referenced service.",
+				Required:    true,
+			},
+			"service_port": {
+				Type:         schema.TypeString,
+				Description:  "Number or name of the port of the referenced service.",
+				Required:     true,
+				ValidateFunc: validatePortNumOrName,
+			},
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func jobSpecFields(isUpdatable bool) map[string]*schema.Schema {
+	template := podTemplateFields(isUpdatable)
+	// Jobs reject the pod default of Always, their pods have to terminate
+	podSpec := template["spec"].Elem.(*schema.Resource).Schema
+	podSpec["restart_policy"].Default = "Never"
+	podSpec["restart_policy"].Description = "Restart policy for all containers within the pod. One of OnFailure, Never. More info: http://kubernetes.io/docs/user-guide/pod-states#restartpolicy."
+	podSpec["restart_policy"].ValidateFunc = validation.StringInSlice([]string{"OnFailure", "Never"}, false)
+	if !isUpdatable {
+		// The pod template of a job cannot be changed once the job is created
+		forceNewFields(template)
+	}
+
+	s := map[string]*schema.Schema{
+		"active_deadline_seconds": {
+			Type:         schema.TypeInt,
+			Description:  "Optional duration in seconds relative to the start time that the job may be active before the system tries to terminate it. Value must be a positive integer.",
+			Optional:     true,
+			ValidateFunc: validatePositiveInteger,
+		},
+		"completions": {
+			Type:         schema.TypeInt,
+			Description:  "Specifies the desired number of successfully finished pods the job should be run with. Defaults to 1. More info: https://kubernetes.io/docs/concepts/workloads/controllers/jobs-run-to-completion/",
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validatePositiveInteger,
+		},
+		"parallelism": {
+			Type:         schema.TypeInt,
+			Description:  "Specifies the maximum desired number of pods the job should run at any given time. Defaults to 1. More info: https://kubernetes.io/docs/concepts/workloads/controllers/jobs-run-to-completion/",
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validatePositiveInteger,
+		},
+		"template": {
+			Type:        schema.TypeList,
+			Description: "Describes the pod that will be created when executing a job. More info: https://kubernetes.io/docs/concepts/workloads/controllers/jobs-run-to-completion/",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: template,
+			},
+		},
+	}
+
+	if !isUpdatable {
+		s["completions"].ForceNew = true
+	}
+
+	return s
+}
+
+// forceNewFields marks every configurable field, including those
+// of nested blocks, as ForceNew.
+func forceNewFields(fields map[string]*schema.Schema) {
+	for _, f := range fields {
+		if f.Computed && !f.Optional {
+			continue
+		}
+		f.ForceNew = true
+		if r, ok := f.Elem.(*schema.Resource); ok {
+			forceNewFields(r.Schema)
+		}
+	}
+}
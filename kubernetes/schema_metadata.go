@@ -14,6 +14,12 @@ func metadataFields(objectName string) map[string]*schema.Schema {
 			Optional:     true,
 			ValidateFunc: validateAnnotations,
 		},
+		"finalizers": {
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("List of finalizers which must be removed by their respective controllers before the %s is deleted from the registry. More info: https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/", objectName),
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
 		"generation": {
 			Type:        schema.TypeInt,
 			Description: "A sequence number representing a specific generation of the desired state.",
@@ -33,6 +39,14 @@ func metadataFields(objectName string) map[string]*schema.Schema {
 			Computed:     true,
 			ValidateFunc: validateName,
 		},
+		"owner_reference": {
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("List of objects the %s depends on. Once all of its owners are deleted, the %s is garbage collected. More info: https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/", objectName, objectName),
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: ownerReferenceFields(),
+			},
+		},
 		"resource_version": {
 			Type:        schema.TypeString,
 			Description: fmt.Sprintf("An opaque value that represents the internal version of this %s that can be used by clients to determine when %s has changed. Read more: https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#concurrency-control-and-consistency", objectName, objectName),
@@ -51,6 +65,41 @@ func metadataFields(objectName string) map[string]*schema.Schema {
 	}
 }
 
+func ownerReferenceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_version": {
+			Type:        schema.TypeString,
+			Description: "API version of the referent.",
+			Required:    true,
+		},
+		"block_owner_deletion": {
+			Type:        schema.TypeBool,
+			Description: "If true, the owner cannot be deleted from the key-value store until this reference is removed. Defaults to `false`.",
+			Optional:    true,
+		},
+		"controller": {
+			Type:        schema.TypeBool,
+			Description: "If true, this reference points to the managing controller. Only one owner reference can be the controller. Defaults to `false`.",
+			Optional:    true,
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Description: "Kind of the referent. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#types-kinds",
+			Required:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+			Required:    true,
+		},
+		"uid": {
+			Type:        schema.TypeString,
+			Description: "UID of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#uids",
+			Required:    true,
+		},
+	}
+}
+
 func metadataSchema(objectName string, generatableName bool) *schema.Schema {
 	fields := metadataFields(objectName)
 
@@ -77,14 +126,24 @@ func metadataSchema(objectName string, generatableName bool) *schema.Schema {
 	}
 }
 
+// templateMetadataSchema is the metadata of an object template, which
+// leaves out the fields the API ignores in templates
+func templateMetadataSchema(objectName string) *schema.Schema {
+	s := metadataSchema(objectName, false)
+	fields := s.Elem.(*schema.Resource).Schema
+	delete(fields, "finalizers")
+	delete(fields, "owner_reference")
+	return s
+}
+
 func namespacedMetadataSchema(objectName string, generatableName bool) *schema.Schema {
 	fields := metadataFields(objectName)
 	fields["namespace"] = &schema.Schema{
 		Type:        schema.TypeString,
-		Description: fmt.Sprintf("Namespace defines the space within which name of the %s must be unique.", objectName),
+		Description: fmt.Sprintf("Namespace defines the space within which name of the %s must be unique. Defaults to the provider's namespace.", objectName),
 		Optional:    true,
 		ForceNew:    true,
-		Default:     "default",
+		Computed:    true,
 	}
 	if generatableName {
 		fields["generate_name"] = &schema.Schema{
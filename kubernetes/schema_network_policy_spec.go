@@ -0,0 +1,77 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func networkPolicySpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ingress": {
+			Type:        schema.TypeList,
+			Description: "List of ingress rules to be applied to the selected pods. Traffic is allowed to a pod if it matches at least one rule. If no rules are specified, no traffic is allowed to the selected pods (isolation must be enabled on their namespace).",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"from": {
+						Type:        schema.TypeList,
+						Description: "List of sources which should be able to access the pods selected for this rule. If empty, this rule matches all sources.",
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"namespace_selector": {
+									Type:        schema.TypeList,
+									Description: "Selects namespaces using cluster scoped-labels. This matches all pods in all namespaces selected by this label selector. An empty selector selects all namespaces.",
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: generateLabelSelector(true),
+									},
+								},
+								"pod_selector": {
+									Type:        schema.TypeList,
+									Description: "Selects pods in the same namespace as the network policy. An empty selector selects all pods in that namespace.",
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: generateLabelSelector(true),
+									},
+								},
+							},
+						},
+					},
+					"ports": {
+						Type:        schema.TypeList,
+						Description: "List of ports which should be made accessible on the pods selected for this rule. If empty, this rule matches all ports.",
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"port": {
+									Type:         schema.TypeString,
+									Description:  "The port on the given protocol. This can either be a numerical or named port on a pod. If not specified, this matches all port names and numbers.",
+									Optional:     true,
+									ValidateFunc: validatePortNumOrName,
+								},
+								"protocol": {
+									Type:         schema.TypeString,
+									Description:  "The protocol (TCP or UDP) which traffic must match. Defaults to TCP.",
+									Optional:     true,
+									Default:      "TCP",
+									ValidateFunc: validateAttributeValueIsIn([]string{"TCP", "UDP"}),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"pod_selector": {
+			Type:        schema.TypeList,
+			Description: "Selects the pods to which this network policy applies. An empty selector selects all pods in the namespace.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: generateLabelSelector(true),
+			},
+		},
+	}
+}
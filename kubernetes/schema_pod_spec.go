@@ -12,6 +12,15 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 			ValidateFunc: validatePositiveInteger,
 			Description:  "Optional duration in seconds the pod may be active on the node relative to StartTime before the system will actively try to mark it failed and kill associated containers. Value must be a positive integer.",
 		},
+		"affinity": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "If specified, the pod's scheduling constraints. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/#affinity-and-anti-affinity",
+			Elem: &schema.Resource{
+				Schema: affinityFields(isUpdatable),
+			},
+		},
 		"container": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -67,6 +76,14 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 				},
 			},
 		},
+		"init_container": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "List of init containers belonging to the pod. Init containers always run to completion and each must complete successfully before the next is started. Init containers cannot currently be added or removed. More info: https://kubernetes.io/docs/concepts/workloads/pods/init-containers/",
+			Elem: &schema.Resource{
+				Schema: containerFields(isUpdatable),
+			},
+		},
 		"node_name": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -144,6 +161,46 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 			ValidateFunc: validateTerminationGracePeriodSeconds,
 			Description:  "Optional duration in seconds the pod needs to terminate gracefully. May be decreased in delete request. Value must be non-negative integer. The value zero indicates delete immediately. If this value is nil, the default grace period will be used instead. The grace period is the duration in seconds after the processes running in the pod are sent a termination signal and the time when the processes are forcibly halted with a kill signal. Set this value longer than the expected cleanup time for your process.",
 		},
+		"toleration": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "If specified, the pod's tolerations. Tolerations can only be added to an existing pod. More info: https://kubernetes.io/docs/concepts/configuration/taint-and-toleration/",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"effect": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Description:  "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
+						ValidateFunc: validateAttributeValueIsIn([]string{"NoSchedule", "PreferNoSchedule", "NoExecute"}),
+					},
+					"key": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.",
+					},
+					"operator": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "Equal",
+						Description:  "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.",
+						ValidateFunc: validateAttributeValueIsIn([]string{"Exists", "Equal"}),
+					},
+					"toleration_seconds": {
+						// Zero means evicting the pod immediately, which is
+						// different from not setting it at all.
+						Type:         schema.TypeString,
+						Optional:     true,
+						Description:  "TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.",
+						ValidateFunc: validateIntegerString,
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.",
+					},
+				},
+			},
+		},
 
 		"volume": {
 			Type:        schema.TypeList,
@@ -156,13 +213,18 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 	if !isUpdatable {
 		for k, _ := range s {
 			if k == "active_deadline_seconds" {
-				// This field is always updatable
+				// Setting or lowering it is allowed, patchPodSpec rejects
+				// any other change
 				continue
 			}
-			if k == "container" {
+			if k == "container" || k == "init_container" {
 				// Some fields are always updatable
 				continue
 			}
+			if k == "toleration" {
+				// Tolerations can be added to existing pods
+				continue
+			}
 			s[k].ForceNew = true
 		}
 	}
@@ -170,6 +232,21 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 	return s
 }
 
+func podTemplateFields(isUpdatable bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": templateMetadataSchema("pod"),
+		"spec": {
+			Type:        schema.TypeList,
+			Description: "Specification of the desired behavior of the pod.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: podSpecFields(isUpdatable),
+			},
+		},
+	}
+}
+
 func volumeSchema() *schema.Resource {
 	v := commonVolumeSources()
 
@@ -263,63 +340,7 @@ func volumeSchema() *schema.Resource {
 					Description: `If unspecified, each key-value pair in the Data field of the referenced ConfigMap will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present. If a key is specified which is not present in the ConfigMap, the volume setup will error. Paths must be relative and may not contain the '..' path or start with '..'.`,
 					Optional:    true,
 					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"field_ref": {
-								Type:        schema.TypeList,
-								Required:    true,
-								MaxItems:    1,
-								Description: "Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.",
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"api_version": {
-											Type:        schema.TypeString,
-											Optional:    true,
-											Default:     "v1",
-											Description: `Version of the schema the FieldPath is written in terms of, defaults to "v1".`,
-										},
-										"field_path": {
-											Type:        schema.TypeString,
-											Optional:    true,
-											Description: "Path of the field to select in the specified API version",
-										},
-									},
-								},
-							},
-							"mode": {
-								Type:        schema.TypeInt,
-								Optional:    true,
-								Description: `Optional: mode bits to use on this file, must be a value between 0 and 0777. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.`,
-							},
-							"path": {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: validateAttributeValueDoesNotContain(".."),
-								Description:  `Path is the relative path name of the file to be created. Must not be absolute or contain the '..' path. Must be utf-8 encoded. The first item of the relative path must not start with '..'`,
-							},
-							"resource_field_ref": {
-								Type:        schema.TypeList,
-								Optional:    true,
-								MaxItems:    1,
-								Description: "Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.",
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"container_name": {
-											Type:     schema.TypeString,
-											Required: true,
-										},
-										"quantity": {
-											Type:     schema.TypeString,
-											Optional: true,
-										},
-										"resource": {
-											Type:        schema.TypeString,
-											Required:    true,
-											Description: "Resource to select",
-										},
-									},
-								},
-							},
-						},
+						Schema: downwardAPIVolumeFileFields(),
 					},
 				},
 			},
@@ -366,6 +387,16 @@ func volumeSchema() *schema.Resource {
 		},
 	}
 
+	v["projected"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Items for all in one resources secrets, configmaps, and downward API",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: projectedVolumeSourceFields(),
+		},
+	}
+
 	v["secret"] = &schema.Schema{
 		Type:        schema.TypeList,
 		Description: "Secret represents a secret that should populate this volume. More info: http://kubernetes.io/docs/user-guide/volumes#secrets",
@@ -427,3 +458,181 @@ func volumeSchema() *schema.Resource {
 		Schema: v,
 	}
 }
+
+func downwardAPIVolumeFileFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"field_ref": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"api_version": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "v1",
+						Description: `Version of the schema the FieldPath is written in terms of, defaults to "v1".`,
+					},
+					"field_path": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Path of the field to select in the specified API version",
+					},
+				},
+			},
+		},
+		"mode": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validateModeBits,
+			Description:  `Optional: mode bits to use on this file, must be a value between 0 and 0777. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.`,
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateAttributeValueDoesNotContain(".."),
+			Description:  `Path is the relative path name of the file to be created. Must not be absolute or contain the '..' path. Must be utf-8 encoded. The first item of the relative path must not start with '..'`,
+		},
+		"resource_field_ref": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"container_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"quantity": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"resource": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Resource to select",
+					},
+				},
+			},
+		},
+	}
+}
+
+func projectedVolumeSourceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"default_mode": {
+			Type:         schema.TypeInt,
+			Description:  "Optional: mode bits to use on created files by default. Must be a value between 0 and 0777. Defaults to 0644. Directories within the path are not affected by this setting. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.",
+			Optional:     true,
+			Default:      0644,
+			ValidateFunc: validateModeBits,
+		},
+		"sources": {
+			Type:        schema.TypeList,
+			Description: "List of volume projections",
+			Required:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"config_map": {
+						Type:        schema.TypeList,
+						Description: "Information about the ConfigMap data to project",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"items": {
+									Type:        schema.TypeList,
+									Description: "If unspecified, each key-value pair in the Data field of the referenced ConfigMap will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present. If a key is specified which is not present in the ConfigMap, the volume setup will error unless it is marked optional. Paths must be relative and may not contain the '..' path or start with '..'.",
+									Optional:    true,
+									Elem: &schema.Resource{
+										Schema: keyToPathFields(),
+									},
+								},
+								"name": {
+									Type:        schema.TypeString,
+									Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+									Required:    true,
+								},
+								"optional": {
+									Type:        schema.TypeBool,
+									Description: "Optional: Specify whether the ConfigMap or it's keys must be defined.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"downward_api": {
+						Type:        schema.TypeList,
+						Description: "Information about the downward API data to project",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"items": {
+									Type:        schema.TypeList,
+									Description: "Items is a list of downward API volume files",
+									Optional:    true,
+									Elem: &schema.Resource{
+										Schema: downwardAPIVolumeFileFields(),
+									},
+								},
+							},
+						},
+					},
+					"secret": {
+						Type:        schema.TypeList,
+						Description: "Information about the secret data to project",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"items": {
+									Type:        schema.TypeList,
+									Description: "If unspecified, each key-value pair in the Data field of the referenced Secret will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present. If a key is specified which is not present in the Secret, the volume setup will error unless it is marked optional. Paths must be relative and may not contain the '..' path or start with '..'.",
+									Optional:    true,
+									Elem: &schema.Resource{
+										Schema: keyToPathFields(),
+									},
+								},
+								"name": {
+									Type:        schema.TypeString,
+									Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+									Required:    true,
+								},
+								"optional": {
+									Type:        schema.TypeBool,
+									Description: "Optional: Specify whether the Secret or it's keys must be defined.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func keyToPathFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The key to project.",
+		},
+		"mode": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validateModeBits,
+			Description:  "Optional: mode bits to use on this file, must be a value between 0 and 0777. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.",
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateAttributeValueDoesNotContain(".."),
+			Description:  "The relative path of the file to map the key to. May not be an absolute path. May not contain the path element '..'. May not start with the string '..'.",
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const rbacAPIGroup = "rbac.authorization.k8s.io"
+
+func policyRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "List of rules that define the set of permissions for this role.",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"api_groups": {
+					Type:        schema.TypeSet,
+					Description: "Name of the API groups that contain the resources. An empty string represents the core API group.",
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+				},
+				"non_resource_urls": {
+					Type:        schema.TypeSet,
+					Description: "Set of partial URLs that a user should have access to. `*` is allowed, but only as the full, final step in the path. Only applicable to cluster roles.",
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+				},
+				"resource_names": {
+					Type:        schema.TypeSet,
+					Description: "White list of names that the rule applies to. An empty set means that everything is allowed.",
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+				},
+				"resources": {
+					Type:        schema.TypeSet,
+					Description: "List of resources this rule applies to. `*` represents all resources.",
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+				},
+				"verbs": {
+					Type:        schema.TypeSet,
+					Description: "List of verbs that apply to all the resources and non-resource URLs contained in this rule. `*` represents all verbs.",
+					Required:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+				},
+			},
+		},
+	}
+}
+
+func roleRefSchema(kinds []string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The role to bind to the subjects. Cannot be updated.",
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"api_group": {
+					Type:        schema.TypeString,
+					Description: "The API group of the referenced role.",
+					Optional:    true,
+					ForceNew:    true,
+					Default:     rbacAPIGroup,
+				},
+				"kind": {
+					Type:         schema.TypeString,
+					Description:  "The kind of the referenced role.",
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validateAttributeValueIsIn(kinds),
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Description: "The name of the referenced role.",
+					Required:    true,
+					ForceNew:    true,
+				},
+			},
+		},
+	}
+}
+
+func subjectSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The users, groups or service accounts the role is bound to.",
+		Required:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"api_group": {
+					Type:        schema.TypeString,
+					Description: "The API group of the subject. Defaults to `rbac.authorization.k8s.io` for users and groups, and to the core API group for service accounts.",
+					Optional:    true,
+					Computed:    true,
+				},
+				"kind": {
+					Type:         schema.TypeString,
+					Description:  "The kind of the subject.",
+					Required:     true,
+					ValidateFunc: validateAttributeValueIsIn([]string{"User", "Group", "ServiceAccount"}),
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Description: "The name of the subject.",
+					Required:    true,
+				},
+				"namespace": {
+					Type:        schema.TypeString,
+					Description: "The namespace of the service account. Must not be set for users and groups.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,225 @@
+package kubernetes
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// diffStrategicMerge returns a strategic merge patch which turns oldV into
+// newV, both of which must be API objects of the same type.
+//
+// Lists tagged with the "merge" patch strategy are diffed element by element,
+// keyed by their patchMergeKey, so entries which aren't part of either object
+// (e.g. containers added by an admission controller) are left untouched.
+// Everything else is replaced as a whole.
+func diffStrategicMerge(oldV, newV interface{}) (map[string]interface{}, error) {
+	t := reflect.TypeOf(newV)
+	if reflect.TypeOf(oldV) != t {
+		return nil, fmt.Errorf("Cannot diff %T against %T", oldV, newV)
+	}
+	o, err := toJSONValue(oldV)
+	if err != nil {
+		return nil, err
+	}
+	n, err := toJSONValue(newV)
+	if err != nil {
+		return nil, err
+	}
+	oldMap, ok := o.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Expected %T to be an object", oldV)
+	}
+	newMap, ok := n.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Expected %T to be an object", newV)
+	}
+	return diffStrategicMaps(indirectType(t), oldMap, newMap), nil
+}
+
+func diffStrategicMaps(t reflect.Type, oldV, newV map[string]interface{}) map[string]interface{} {
+	fields := jsonFields(t)
+	patch := make(map[string]interface{})
+	for k := range oldV {
+		if _, ok := newV[k]; !ok {
+			patch[k] = nil
+		}
+	}
+	for k, n := range newV {
+		o, ok := oldV[k]
+		if !ok {
+			patch[k] = n
+			continue
+		}
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		f, ok := fields[k]
+		if !ok {
+			patch[k] = n
+			continue
+		}
+
+		ft := indirectType(f.Type)
+		oldMap, oldIsMap := o.(map[string]interface{})
+		newMap, newIsMap := n.(map[string]interface{})
+		oldList, oldIsList := o.([]interface{})
+		newList, newIsList := n.([]interface{})
+		switch {
+		case (ft.Kind() == reflect.Struct || ft.Kind() == reflect.Map) && oldIsMap && newIsMap:
+			patch[k] = diffStrategicMaps(ft, oldMap, newMap)
+		case ft.Kind() == reflect.Slice && hasMergeStrategy(f) && oldIsList && newIsList:
+			key := f.Tag.Get("patchMergeKey")
+			if key == "" {
+				added, removed := diffPrimitiveLists(oldList, newList)
+				if len(added) > 0 {
+					patch[k] = added
+				}
+				if len(removed) > 0 {
+					patch["$deleteFromPrimitiveList/"+k] = removed
+				}
+				continue
+			}
+			patch[k] = diffStrategicLists(indirectType(ft.Elem()), key, oldList, newList)
+		default:
+			patch[k] = n
+		}
+	}
+	return patch
+}
+
+// diffStrategicLists diffs lists whose elements are identified by the value
+// of their key field. Elements only present in newV are added in full, those
+// present in both are patched and those only present in oldV are deleted.
+func diffStrategicLists(t reflect.Type, key string, oldV, newV []interface{}) []interface{} {
+	oldByKey, ok := indexByMergeKey(key, oldV)
+	if !ok {
+		return replaceStrategicList(newV)
+	}
+	newByKey, ok := indexByMergeKey(key, newV)
+	if !ok {
+		return replaceStrategicList(newV)
+	}
+
+	patch := make([]interface{}, 0)
+	for _, v := range newV {
+		n := v.(map[string]interface{})
+		o, ok := oldByKey[n[key]]
+		if !ok {
+			patch = append(patch, n)
+			continue
+		}
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		p := diffStrategicMaps(t, o, n)
+		p[key] = n[key]
+		patch = append(patch, p)
+	}
+	for _, v := range oldV {
+		o := v.(map[string]interface{})
+		if _, ok := newByKey[o[key]]; !ok {
+			patch = append(patch, map[string]interface{}{
+				key:      o[key],
+				"$patch": "delete",
+			})
+		}
+	}
+	return patch
+}
+
+// indexByMergeKey maps every element of l by the value of its key field.
+// It returns false if any element lacks the key or shares it with another.
+func indexByMergeKey(key string, l []interface{}) (map[interface{}]map[string]interface{}, bool) {
+	m := make(map[interface{}]map[string]interface{}, len(l))
+	for _, v := range l {
+		e, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		k, ok := e[key]
+		if !ok || k == nil {
+			return nil, false
+		}
+		if _, ok := m[k]; ok {
+			return nil, false
+		}
+		m[k] = e
+	}
+	return m, true
+}
+
+// replaceStrategicList makes the server replace a merged list entirely,
+// which is the only option when its elements can't be told apart
+func replaceStrategicList(l []interface{}) []interface{} {
+	return append(append(make([]interface{}, 0, len(l)+1), l...),
+		map[string]interface{}{"$patch": "replace"})
+}
+
+func diffPrimitiveLists(oldV, newV []interface{}) ([]interface{}, []interface{}) {
+	added := make([]interface{}, 0)
+	for _, n := range newV {
+		if !containsValue(oldV, n) {
+			added = append(added, n)
+		}
+	}
+	removed := make([]interface{}, 0)
+	for _, o := range oldV {
+		if !containsValue(newV, o) {
+			removed = append(removed, o)
+		}
+	}
+	return added, removed
+}
+
+func containsValue(l []interface{}, v interface{}) bool {
+	for _, e := range l {
+		if reflect.DeepEqual(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasMergeStrategy(f reflect.StructField) bool {
+	for _, s := range strings.Split(f.Tag.Get("patchStrategy"), ",") {
+		if s == "merge" {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonFields maps the JSON names of the fields of t, including those of
+// inlined structs, to the fields themselves
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	if t.Kind() != reflect.Struct {
+		return fields
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			for k, v := range jsonFields(indirectType(f.Type)) {
+				fields[k] = v
+			}
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f
+	}
+	return fields
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,213 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+func TestDiffStrategicMerge(t *testing.T) {
+	testCases := []struct {
+		Old           interface{}
+		New           interface{}
+		ExpectedPatch string
+	}{
+		{
+			Old: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "web"},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: "app", Image: "nginx:1.7.8"}},
+				},
+			},
+			New: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "web"},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: "app", Image: "nginx:1.7.8"}},
+				},
+			},
+			ExpectedPatch: `{}`,
+		},
+		{
+			Old: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "app", Image: "nginx:1.7.8"},
+						{Name: "logger", Image: "fluentd:1"},
+					},
+				},
+			},
+			New: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "logger", Image: "fluentd:1"},
+						{Name: "app", Image: "nginx:1.7.9"},
+					},
+				},
+			},
+			ExpectedPatch: `{"spec": {"containers": [{"name": "app", "image": "nginx:1.7.9"}]}}`,
+		},
+		{
+			Old: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "app", Image: "nginx:1.7.8"},
+						{Name: "logger", Image: "fluentd:1"},
+					},
+				},
+			},
+			New: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "app", Image: "nginx:1.7.8"},
+						{Name: "metrics", Image: "prometheus:1"},
+					},
+				},
+			},
+			ExpectedPatch: `{"spec": {"containers": [
+				{"name": "metrics", "image": "prometheus:1", "resources": {}},
+				{"name": "logger", "$patch": "delete"}
+			]}}`,
+		},
+		{
+			Old: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name: "app",
+						Env: []v1.EnvVar{
+							{Name: "A", Value: "1"},
+							{Name: "B", Value: "2"},
+						},
+						Ports: []v1.ContainerPort{
+							{ContainerPort: 80, Name: "http"},
+							{ContainerPort: 443, Name: "https"},
+						},
+						VolumeMounts: []v1.VolumeMount{
+							{Name: "data", MountPath: "/data"},
+						},
+					}},
+				},
+			},
+			New: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name: "app",
+						Env: []v1.EnvVar{
+							{Name: "B", Value: "3"},
+						},
+						Ports: []v1.ContainerPort{
+							{ContainerPort: 80, Name: "web"},
+							{ContainerPort: 443, Name: "https"},
+						},
+						VolumeMounts: []v1.VolumeMount{
+							{Name: "data", MountPath: "/data", ReadOnly: true},
+						},
+					}},
+				},
+			},
+			ExpectedPatch: `{"spec": {"containers": [{
+				"name": "app",
+				"env": [
+					{"name": "B", "value": "3"},
+					{"name": "A", "$patch": "delete"}
+				],
+				"ports": [{"containerPort": 80, "name": "web"}],
+				"volumeMounts": [{"mountPath": "/data", "readOnly": true}]
+			}]}}`,
+		},
+		{
+			Old: &v1.Pod{
+				Spec: v1.PodSpec{
+					Volumes: []v1.Volume{
+						{Name: "a", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+						{Name: "b", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+					},
+				},
+			},
+			New: &v1.Pod{
+				Spec: v1.PodSpec{
+					Volumes: []v1.Volume{
+						{Name: "b", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{Medium: "Memory"}}},
+					},
+				},
+			},
+			ExpectedPatch: `{"spec": {"volumes": [
+				{"name": "b", "emptyDir": {"medium": "Memory"}},
+				{"name": "a", "$patch": "delete"}
+			]}}`,
+		},
+		{
+			Old: &v1.ReplicationController{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:     map[string]string{"app": "web", "tier": "frontend"},
+					Finalizers: []string{"a", "b"},
+				},
+			},
+			New: &v1.ReplicationController{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:     map[string]string{"app": "web", "tier": "backend", "env": "test"},
+					Finalizers: []string{"b", "c"},
+				},
+			},
+			ExpectedPatch: `{"metadata": {
+				"labels": {"tier": "backend", "env": "test"},
+				"finalizers": ["c"],
+				"$deleteFromPrimitiveList/finalizers": ["a"]
+			}}`,
+		},
+		{
+			Old: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "app", Image: "nginx:1.7.8"},
+						{Name: "app", Image: "nginx:1.7.8"},
+					},
+				},
+			},
+			New: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "app", Image: "nginx:1.7.9"},
+					},
+				},
+			},
+			ExpectedPatch: `{"spec": {"containers": [
+				{"name": "app", "image": "nginx:1.7.9", "resources": {}},
+				{"$patch": "replace"}
+			]}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			patch, err := diffStrategicMerge(tc.Old, tc.New)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := json.Marshal(patch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var given, expected interface{}
+			if err := json.Unmarshal(b, &given); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tc.ExpectedPatch), &expected); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(expected, given) {
+				t.Fatalf("Patches don't match.\nExpected: %s\nGiven:    %s\n", tc.ExpectedPatch, b)
+			}
+		})
+	}
+}
+
+func TestDiffStrategicMergeTypeMismatch(t *testing.T) {
+	_, err := diffStrategicMerge(&v1.Pod{}, &v1.ReplicationController{})
+	if err == nil {
+		t.Fatal("Expected diffing different types to fail")
+	}
+}
@@ -0,0 +1,160 @@
+package kubernetes
+
+import (
+	batch_v2alpha1 "k8s.io/kubernetes/pkg/apis/batch/v2alpha1"
+)
+
+// Expanders
+
+func expandCronJobSpec(l []interface{}) (batch_v2alpha1.CronJobSpec, error) {
+	obj := batch_v2alpha1.CronJobSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.Schedule = in["schedule"].(string)
+	obj.ConcurrencyPolicy = batch_v2alpha1.ConcurrencyPolicy(in["concurrency_policy"].(string))
+	obj.Suspend = ptrToBool(in["suspend"].(bool))
+	obj.SuccessfulJobsHistoryLimit = ptrToInt32(int32(in["successful_jobs_history_limit"].(int)))
+	obj.FailedJobsHistoryLimit = ptrToInt32(int32(in["failed_jobs_history_limit"].(int)))
+
+	if v, ok := in["starting_deadline_seconds"].(int); ok && v > 0 {
+		obj.StartingDeadlineSeconds = ptrToInt64(int64(v))
+	}
+
+	template, err := expandJobTemplate(in["job_template"].([]interface{}))
+	if err != nil {
+		return obj, err
+	}
+	obj.JobTemplate = template
+
+	return obj, nil
+}
+
+func expandJobTemplate(l []interface{}) (batch_v2alpha1.JobTemplateSpec, error) {
+	obj := batch_v2alpha1.JobTemplateSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["metadata"].([]interface{}); ok {
+		obj.ObjectMeta = expandMetadata(v)
+	}
+
+	spec, err := expandJobSpec(in["spec"].([]interface{}))
+	if err != nil {
+		return obj, err
+	}
+	spec.Template.Spec.AutomountServiceAccountToken = ptrToBool(false)
+	obj.Spec = spec
+
+	return obj, nil
+}
+
+// Flatteners
+
+func flattenCronJobSpec(in batch_v2alpha1.CronJobSpec) ([]interface{}, error) {
+	att := make(map[string]interface{})
+
+	att["schedule"] = in.Schedule
+	att["concurrency_policy"] = string(in.ConcurrencyPolicy)
+	if in.Suspend != nil {
+		att["suspend"] = *in.Suspend
+	}
+	if in.StartingDeadlineSeconds != nil {
+		att["starting_deadline_seconds"] = *in.StartingDeadlineSeconds
+	}
+	if in.SuccessfulJobsHistoryLimit != nil {
+		att["successful_jobs_history_limit"] = *in.SuccessfulJobsHistoryLimit
+	}
+	if in.FailedJobsHistoryLimit != nil {
+		att["failed_jobs_history_limit"] = *in.FailedJobsHistoryLimit
+	}
+
+	template, err := flattenJobTemplate(in.JobTemplate)
+	if err != nil {
+		return nil, err
+	}
+	att["job_template"] = template
+
+	return []interface{}{att}, nil
+}
+
+func flattenJobTemplate(in batch_v2alpha1.JobTemplateSpec) ([]interface{}, error) {
+	att := make(map[string]interface{})
+
+	att["metadata"] = flattenTemplateMetadata(in.ObjectMeta)
+
+	spec, err := flattenJobSpec(in.Spec)
+	if err != nil {
+		return nil, err
+	}
+	att["spec"] = spec
+
+	return []interface{}{att}, nil
+}
+
+// Patchers
+
+func patchCronJobSpec(prefix string, pathPrefix string, d resourceChanges) ([]PatchOperation, error) {
+	ops := make([]PatchOperation, 0)
+
+	if d.HasChange(prefix + "schedule") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  pathPrefix + "/schedule",
+			Value: d.Get(prefix + "schedule").(string),
+		})
+	}
+	if d.HasChange(prefix + "concurrency_policy") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  pathPrefix + "/concurrencyPolicy",
+			Value: d.Get(prefix + "concurrency_policy").(string),
+		})
+	}
+	if d.HasChange(prefix + "suspend") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  pathPrefix + "/suspend",
+			Value: d.Get(prefix + "suspend").(bool),
+		})
+	}
+	if d.HasChange(prefix + "starting_deadline_seconds") {
+		v := d.Get(prefix + "starting_deadline_seconds").(int)
+		if v > 0 {
+			ops = append(ops, &AddOperation{
+				Path:  pathPrefix + "/startingDeadlineSeconds",
+				Value: v,
+			})
+		} else {
+			ops = append(ops, &RemoveOperation{
+				Path: pathPrefix + "/startingDeadlineSeconds",
+			})
+		}
+	}
+	if d.HasChange(prefix + "successful_jobs_history_limit") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "/successfulJobsHistoryLimit",
+			Value: d.Get(prefix + "successful_jobs_history_limit").(int),
+		})
+	}
+	if d.HasChange(prefix + "failed_jobs_history_limit") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "/failedJobsHistoryLimit",
+			Value: d.Get(prefix + "failed_jobs_history_limit").(int),
+		})
+	}
+	if d.HasChange(prefix + "job_template") {
+		// Changes to the template only affect jobs created afterwards,
+		// so the whole template can be swapped at once
+		template, err := expandJobTemplate(d.Get(prefix + "job_template").([]interface{}))
+		if err != nil {
+			return ops, err
+		}
+		ops = append(ops, &ReplaceOperation{
+			Path:  pathPrefix + "/jobTemplate",
+			Value: template,
+		})
+	}
+	return ops, nil
+}
@@ -0,0 +1,134 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api/v1"
+	batch_v1 "k8s.io/kubernetes/pkg/apis/batch/v1"
+	batch_v2alpha1 "k8s.io/kubernetes/pkg/apis/batch/v2alpha1"
+)
+
+func TestExpandCronJobSpec(t *testing.T) {
+	cases := []struct {
+		Input    []interface{}
+		Expected batch_v2alpha1.CronJobSpec
+	}{
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"concurrency_policy":            "Forbid",
+					"failed_jobs_history_limit":     1,
+					"schedule":                      "*/5 * * * *",
+					"starting_deadline_seconds":     0,
+					"successful_jobs_history_limit": 3,
+					"suspend":                       false,
+					"job_template": []interface{}{
+						map[string]interface{}{
+							"spec": []interface{}{
+								map[string]interface{}{
+									"active_deadline_seconds": 0,
+									"completions":             1,
+									"parallelism":             1,
+									"template":                []interface{}{},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expected: batch_v2alpha1.CronJobSpec{
+				ConcurrencyPolicy:          batch_v2alpha1.ForbidConcurrent,
+				FailedJobsHistoryLimit:     ptrToInt32(int32(1)),
+				Schedule:                   "*/5 * * * *",
+				SuccessfulJobsHistoryLimit: ptrToInt32(int32(3)),
+				Suspend:                    ptrToBool(false),
+				JobTemplate: batch_v2alpha1.JobTemplateSpec{
+					Spec: batch_v1.JobSpec{
+						Completions: ptrToInt32(int32(1)),
+						Parallelism: ptrToInt32(int32(1)),
+						Template: v1.PodTemplateSpec{
+							Spec: v1.PodSpec{
+								AutomountServiceAccountToken: ptrToBool(false),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"concurrency_policy":            "Allow",
+					"failed_jobs_history_limit":     0,
+					"schedule":                      "@daily",
+					"starting_deadline_seconds":     60,
+					"successful_jobs_history_limit": 0,
+					"suspend":                       true,
+					"job_template":                  []interface{}{},
+				},
+			},
+			Expected: batch_v2alpha1.CronJobSpec{
+				ConcurrencyPolicy:          batch_v2alpha1.AllowConcurrent,
+				FailedJobsHistoryLimit:     ptrToInt32(int32(0)),
+				Schedule:                   "@daily",
+				StartingDeadlineSeconds:    ptrToInt64(int64(60)),
+				SuccessfulJobsHistoryLimit: ptrToInt32(int32(0)),
+				Suspend:                    ptrToBool(true),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := expandCronJobSpec(tc.Input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(output, tc.Expected) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.Expected, output)
+		}
+	}
+}
+
+func TestFlattenCronJobSpec(t *testing.T) {
+	in := batch_v2alpha1.CronJobSpec{
+		ConcurrencyPolicy:          batch_v2alpha1.ReplaceConcurrent,
+		FailedJobsHistoryLimit:     ptrToInt32(int32(2)),
+		Schedule:                   "0 3 * * *",
+		StartingDeadlineSeconds:    ptrToInt64(int64(30)),
+		SuccessfulJobsHistoryLimit: ptrToInt32(int32(5)),
+		Suspend:                    ptrToBool(true),
+		JobTemplate: batch_v2alpha1.JobTemplateSpec{
+			Spec: batch_v1.JobSpec{
+				Completions: ptrToInt32(int32(2)),
+				Parallelism: ptrToInt32(int32(1)),
+			},
+		},
+	}
+
+	output, err := flattenCronJobSpec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := output[0].(map[string]interface{})
+
+	expected := map[string]interface{}{
+		"concurrency_policy":            "Replace",
+		"failed_jobs_history_limit":     int32(2),
+		"schedule":                      "0 3 * * *",
+		"starting_deadline_seconds":     int64(30),
+		"successful_jobs_history_limit": int32(5),
+		"suspend":                       true,
+	}
+	for k, v := range expected {
+		if !reflect.DeepEqual(spec[k], v) {
+			t.Fatalf("Unexpected %s.\nExpected: %#v\nGiven:    %#v", k, v, spec[k])
+		}
+	}
+
+	jobSpec := spec["job_template"].([]interface{})[0].(map[string]interface{})["spec"].([]interface{})[0].(map[string]interface{})
+	if jobSpec["completions"] != int32(2) {
+		t.Fatalf("Expected job template completions to be 2, given %#v", jobSpec["completions"])
+	}
+}
@@ -0,0 +1,115 @@
+package kubernetes
+
+import (
+	ex_v1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+// Expanders
+
+func expandDaemonSetSpec(l []interface{}) (ex_v1beta1.DaemonSetSpec, error) {
+	obj := ex_v1beta1.DaemonSetSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.MinReadySeconds = int32(in["min_ready_seconds"].(int))
+	if v, ok := in["selector"].([]interface{}); ok && len(v) > 0 {
+		obj.Selector = expandLabelSelector(v)
+	}
+	if v, ok := in["update_strategy"].([]interface{}); ok && len(v) > 0 {
+		obj.UpdateStrategy = expandDaemonSetUpdateStrategy(v)
+	}
+
+	template, err := expandTemplateReferance(in["template"].([]interface{}))
+	if err != nil {
+		return obj, err
+	}
+	obj.Template = template
+
+	return obj, nil
+}
+
+func expandDaemonSetUpdateStrategy(l []interface{}) ex_v1beta1.DaemonSetUpdateStrategy {
+	obj := ex_v1beta1.DaemonSetUpdateStrategy{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["type"].(string); ok {
+		obj.Type = ex_v1beta1.DaemonSetUpdateStrategyType(v)
+	}
+	if obj.Type != ex_v1beta1.RollingUpdateDaemonSetStrategyType {
+		return obj
+	}
+	if v, ok := in["rolling_update"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ru := v[0].(map[string]interface{})
+		obj.RollingUpdate = &ex_v1beta1.RollingUpdateDaemonSet{}
+		if mu, ok := ru["max_unavailable"].(string); ok && mu != "" {
+			maxUnavailable := expandPort(mu)
+			obj.RollingUpdate.MaxUnavailable = &maxUnavailable
+		}
+	}
+	return obj
+}
+
+// Flatteners
+
+func flattenDaemonSetSpec(in ex_v1beta1.DaemonSetSpec) ([]interface{}, error) {
+	att := make(map[string]interface{})
+
+	att["min_ready_seconds"] = in.MinReadySeconds
+	if in.Selector != nil {
+		att["selector"] = flattenLabelSelector(in.Selector)
+	}
+	att["update_strategy"] = flattenDaemonSetUpdateStrategy(in.UpdateStrategy)
+
+	template, err := flattenTemplateReferance(in.Template)
+	if err != nil {
+		return nil, err
+	}
+	att["template"] = template
+
+	return []interface{}{att}, nil
+}
+
+func flattenDaemonSetUpdateStrategy(in ex_v1beta1.DaemonSetUpdateStrategy) []interface{} {
+	att := make(map[string]interface{})
+	att["type"] = string(in.Type)
+	if in.RollingUpdate != nil {
+		ru := make(map[string]interface{})
+		if in.RollingUpdate.MaxUnavailable != nil {
+			ru["max_unavailable"] = in.RollingUpdate.MaxUnavailable.String()
+		}
+		att["rolling_update"] = []interface{}{ru}
+	}
+	return []interface{}{att}
+}
+
+// Patchers
+
+func patchDaemonSetSpec(prefix string, pathPrefix string, d resourceChanges) ([]PatchOperation, error) {
+	ops := make([]PatchOperation, 0)
+
+	if d.HasChange(prefix + "min_ready_seconds") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  pathPrefix + "/minReadySeconds",
+			Value: d.Get(prefix + "min_ready_seconds").(int),
+		})
+	}
+	if d.HasChange(prefix + "update_strategy") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  pathPrefix + "/updateStrategy",
+			Value: expandDaemonSetUpdateStrategy(d.Get(prefix + "update_strategy").([]interface{})),
+		})
+	}
+	if d.HasChange(prefix + "template") {
+		diffOps, err := patchTemplateReferance(prefix+"template", pathPrefix+"/template", d)
+		if err != nil {
+			return ops, err
+		}
+		ops = append(ops, diffOps...)
+	}
+	return ops, nil
+}
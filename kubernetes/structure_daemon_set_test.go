@@ -0,0 +1,113 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ex_v1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+func TestExpandDaemonSetUpdateStrategy(t *testing.T) {
+	cases := []struct {
+		Input    []interface{}
+		Expected ex_v1beta1.DaemonSetUpdateStrategy
+	}{
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"type": "OnDelete",
+					"rolling_update": []interface{}{
+						map[string]interface{}{
+							"max_unavailable": "1",
+						},
+					},
+				},
+			},
+			Expected: ex_v1beta1.DaemonSetUpdateStrategy{
+				Type: ex_v1beta1.OnDeleteDaemonSetStrategyType,
+			},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"type": "RollingUpdate",
+					"rolling_update": []interface{}{
+						map[string]interface{}{
+							"max_unavailable": "20%",
+						},
+					},
+				},
+			},
+			Expected: ex_v1beta1.DaemonSetUpdateStrategy{
+				Type: ex_v1beta1.RollingUpdateDaemonSetStrategyType,
+				RollingUpdate: &ex_v1beta1.RollingUpdateDaemonSet{
+					MaxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "20%"},
+				},
+			},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"type":           "RollingUpdate",
+					"rolling_update": []interface{}{},
+				},
+			},
+			Expected: ex_v1beta1.DaemonSetUpdateStrategy{
+				Type: ex_v1beta1.RollingUpdateDaemonSetStrategyType,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandDaemonSetUpdateStrategy(tc.Input)
+		if !reflect.DeepEqual(output, tc.Expected) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.Expected, output)
+		}
+	}
+}
+
+func TestFlattenDaemonSetUpdateStrategy(t *testing.T) {
+	cases := []struct {
+		Input    ex_v1beta1.DaemonSetUpdateStrategy
+		Expected []interface{}
+	}{
+		{
+			Input: ex_v1beta1.DaemonSetUpdateStrategy{
+				Type: ex_v1beta1.OnDeleteDaemonSetStrategyType,
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"type": "OnDelete",
+				},
+			},
+		},
+		{
+			Input: ex_v1beta1.DaemonSetUpdateStrategy{
+				Type: ex_v1beta1.RollingUpdateDaemonSetStrategyType,
+				RollingUpdate: &ex_v1beta1.RollingUpdateDaemonSet{
+					MaxUnavailable: &intstr.IntOrString{Type: intstr.Int, IntVal: 2},
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"type": "RollingUpdate",
+					"rolling_update": []interface{}{
+						map[string]interface{}{
+							"max_unavailable": "2",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenDaemonSetUpdateStrategy(tc.Input)
+		if !reflect.DeepEqual(output, tc.Expected) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.Expected, output)
+		}
+	}
+}
@@ -3,8 +3,6 @@ package kubernetes
 import (
 	"strconv"
 
-	"github.com/hashicorp/terraform/helper/schema"
-
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	v1 "k8s.io/kubernetes/pkg/api/v1"
@@ -13,9 +11,9 @@ import (
 
 // Expanders
 
-func expandDeploymentSpec(in []interface{}) ex_v1beta1.DeploymentSpec {
+func expandDeploymentSpec(in []interface{}) (ex_v1beta1.DeploymentSpec, error) {
 	if len(in) == 0 || in[0] == nil {
-		return ex_v1beta1.DeploymentSpec{}
+		return ex_v1beta1.DeploymentSpec{}, nil
 	}
 
 	spec := ex_v1beta1.DeploymentSpec{}
@@ -29,22 +27,29 @@ func expandDeploymentSpec(in []interface{}) ex_v1beta1.DeploymentSpec {
 	if v, ok := m["progress_deadline_seconds"].(int); ok {
 		spec.ProgressDeadlineSeconds = ptrToInt32(int32(v))
 	}
-	if v, ok := m["replicas"].(int); ok && v > 0 {
+	if v, ok := m["replicas"].(int); ok {
 		spec.Replicas = ptrToInt32(int32(v))
 	}
-	if v, ok := m["revision_history_limit"].(int); ok && v > 0 {
+	if v, ok := m["revision_history_limit"].(int); ok {
 		spec.RevisionHistoryLimit = ptrToInt32(int32(v))
 	}
-	if v, ok := m["selector"]; ok {
-		spec.Selector = expandSelectorReferance(v.([]interface{}))
+	if v, ok := m["selector"].([]interface{}); ok && len(v) > 0 {
+		spec.Selector = expandSelectorReferance(v)
 	}
-	if v, ok := m["rollback_to"]; ok {
-		spec.RollbackTo = expandRollbackToReferance(v.([]interface{}))
+	if v, ok := m["rollback_to"].([]interface{}); ok && len(v) > 0 {
+		spec.RollbackTo = expandRollbackToReferance(v)
 	}
 	if v, ok := m["strategy"]; ok {
 		spec.Strategy = expandStrategyReferance(v.([]interface{}))
 	}
-	return spec
+	if v, ok := m["template"]; ok {
+		template, err := expandTemplateReferance(v.([]interface{}))
+		if err != nil {
+			return spec, err
+		}
+		spec.Template = template
+	}
+	return spec, nil
 }
 
 func expandRollbackToReferance(in []interface{}) *ex_v1beta1.RollbackConfig {
@@ -85,8 +90,8 @@ func expandStrategyReferance(in []interface{}) ex_v1beta1.DeploymentStrategy {
 	if v, ok := m["type"].(string); ok {
 		strategy.Type = ex_v1beta1.DeploymentStrategyType(v)
 	}
-	if v, ok := m["rolling_update"]; ok {
-		strategy.RollingUpdate = expandRollingUpdateReferance(v.([]interface{}))
+	if v, ok := m["rolling_update"].([]interface{}); ok && len(v) > 0 && strategy.Type == ex_v1beta1.RollingUpdateDeploymentStrategyType {
+		strategy.RollingUpdate = expandRollingUpdateReferance(v)
 	}
 	return strategy
 }
@@ -152,109 +157,79 @@ func expandTemplateReferance(in []interface{}) (v1.PodTemplateSpec, error) {
 	return pt, nil
 }
 
-func expandDeploymentStatus(in []interface{}) ex_v1beta1.DeploymentStatus {
-	if len(in) == 0 || in[0] == nil {
-		return ex_v1beta1.DeploymentStatus{}
-	}
-
-	status := ex_v1beta1.DeploymentStatus{}
-	m := in[0].(map[string]interface{})
-	if v, ok := m["available_replicas"]; ok {
-		status.AvailableReplicas = int32(v.(int))
-	}
-	if v, ok := m["observed_generation"]; ok {
-		status.ObservedGeneration = int64(v.(int))
-	}
-	if v, ok := m["replicas"]; ok {
-		status.Replicas = int32(v.(int))
-	}
-	if v, ok := m["updated_replicas"]; ok {
-		status.UpdatedReplicas = int32(v.(int))
+// patchTemplateReferance diffs the old and new pod template, so only the
+// changed parts of the template are sent to the API
+func patchTemplateReferance(key, path string, d resourceChanges) (PatchOperations, error) {
+	o, n := d.GetChange(key)
+	oldTemplate, err := expandTemplateReferance(o.([]interface{}))
+	if err != nil {
+		return nil, err
 	}
-	if v, ok := m["ready_replicas"]; ok {
-		status.ReadyReplicas = int32(v.(int))
-	}
-	if v, ok := m["conditions"].([]interface{}); ok && len(v) > 0 {
-		conditions, _ := expandDeploymentStatusCondition(v)
-		status.Conditions = conditions
-	}
-
-	return status
-}
-
-func expandDeploymentStatusCondition(in []interface{}) ([]ex_v1beta1.DeploymentCondition, error) {
-	if len(in) == 0 {
-		return []ex_v1beta1.DeploymentCondition{}, nil
-	}
-
-	cnds := make([]ex_v1beta1.DeploymentCondition, len(in))
-	for i, c := range in {
-		m := c.(map[string]interface{})
-
-		if v, ok := m["type"]; ok {
-			cnds[i].Type = ex_v1beta1.DeploymentConditionType(v.(string))
-		}
-		if v, ok := m["status"]; ok {
-			cnds[i].Status = v1.ConditionStatus(v.(string))
-		}
-		if v, ok := m["reason"]; ok {
-			cnds[i].Reason = v.(string)
-		}
-		if v, ok := m["message"]; ok {
-			cnds[i].Message = v.(string)
-		}
+	newTemplate, err := expandTemplateReferance(n.([]interface{}))
+	if err != nil {
+		return nil, err
 	}
-	return cnds, nil
+	return diffObjects(path, oldTemplate, newTemplate)
 }
 
 // Flatteners
 
-func flattenDeploymentSpec(spec ex_v1beta1.DeploymentSpec) []interface{} {
+func flattenDeploymentSpec(spec ex_v1beta1.DeploymentSpec) ([]interface{}, error) {
 	m := make(map[string]interface{}, 0)
 	if spec.Replicas != nil {
 		m["replicas"] = *spec.Replicas
 	}
-	m["selector"] = flattenLabelSelectorReferance(spec.Selector)
-	m["template"] = flattenTemplateReferance(spec.Template)
+	if spec.Selector != nil {
+		m["selector"] = flattenLabelSelector(spec.Selector)
+	}
+	template, err := flattenTemplateReferance(spec.Template)
+	if err != nil {
+		return nil, err
+	}
+	m["template"] = template
 	m["strategy"] = flattenStrategyReferance(spec.Strategy)
 	m["min_ready_seconds"] = spec.MinReadySeconds
 	if spec.RevisionHistoryLimit != nil {
 		m["revision_history_limit"] = *spec.RevisionHistoryLimit
 	}
 	m["paused"] = spec.Paused
-	m["rollback_to"] = flattenRollbackToReferance(spec.RollbackTo)
+	if spec.RollbackTo != nil {
+		m["rollback_to"] = flattenRollbackToReferance(spec.RollbackTo)
+	}
 	if spec.ProgressDeadlineSeconds != nil {
 		m["progress_deadline_seconds"] = *spec.ProgressDeadlineSeconds
 	}
-	return []interface{}{m}
+	return []interface{}{m}, nil
 }
 
-func flattenLabelSelectorReferance(selector *metav1.LabelSelector) []interface{} {
+func flattenTemplateReferance(template v1.PodTemplateSpec) ([]interface{}, error) {
 	m := make(map[string]interface{}, 0)
-	m["match_labels"] = selector.MatchLabels
-	m["match_expressions"] = flattenLabelSelectorRequirementList(selector.MatchExpressions)
-	return []interface{}{m}
-}
-
-func flattenTemplateReferance(template v1.PodTemplateSpec) []interface{} {
-	m := make(map[string]interface{}, 0)
-	m["metadata"] = flattenMetadata(template.ObjectMeta)
-	podSpec, _ := flattenPodSpec(template.Spec)
+	m["metadata"] = flattenTemplateMetadata(template.ObjectMeta)
+	podSpec, err := flattenPodSpec(template.Spec)
+	if err != nil {
+		return nil, err
+	}
 	m["spec"] = podSpec
-	return []interface{}{m}
+	return []interface{}{m}, nil
 }
 
 func flattenStrategyReferance(in ex_v1beta1.DeploymentStrategy) []interface{} {
 	m := make(map[string]interface{}, 0)
 	m["type"] = string(in.Type)
-	m["rolling_update"] = flattenRollingUpdateDeployment(in.RollingUpdate)
+	if in.RollingUpdate != nil {
+		m["rolling_update"] = flattenRollingUpdateDeployment(in.RollingUpdate)
+	}
 	return []interface{}{m}
 }
 
 func flattenRollingUpdateDeployment(in *ex_v1beta1.RollingUpdateDeployment) []interface{} {
 	m := make(map[string]interface{}, 0)
-	m["max_unavailable"] = flattenIntOrString(*in.MaxUnavailable)
-	m["max_surge"] = flattenIntOrString(*in.MaxSurge)
+	if in.MaxUnavailable != nil {
+		m["max_unavailable"] = in.MaxUnavailable.String()
+	}
+	if in.MaxSurge != nil {
+		m["max_surge"] = in.MaxSurge.String()
+	}
 	return []interface{}{m}
 }
 
@@ -268,11 +243,11 @@ func flattenDeploymentStatus(in ex_v1beta1.DeploymentStatus) []interface{} {
 	m := make(map[string]interface{}, 0)
 	m["observed_generation"] = in.ObservedGeneration
 	m["replicas"] = in.Replicas
-	m["update_replicas"] = in.UpdatedReplicas
+	m["updated_replicas"] = in.UpdatedReplicas
 	m["ready_replicas"] = in.ReadyReplicas
 	m["available_replicas"] = in.AvailableReplicas
 	m["unavailable_replicas"] = in.UnavailableReplicas
-	conditions := make([]map[string]string, 0)
+	conditions := make([]interface{}, 0, len(in.Conditions))
 	for _, val := range in.Conditions {
 		conditions = append(conditions, flattenDeploymentConditionReferance(val))
 	}
@@ -280,8 +255,8 @@ func flattenDeploymentStatus(in ex_v1beta1.DeploymentStatus) []interface{} {
 	return []interface{}{m}
 }
 
-func flattenDeploymentConditionReferance(in ex_v1beta1.DeploymentCondition) map[string]string {
-	m := make(map[string]string, 0)
+func flattenDeploymentConditionReferance(in ex_v1beta1.DeploymentCondition) map[string]interface{} {
+	m := make(map[string]interface{}, 0)
 	m["type"] = string(in.Type)
 	m["status"] = string(in.Status)
 	m["reason"] = in.Reason
@@ -291,7 +266,7 @@ func flattenDeploymentConditionReferance(in ex_v1beta1.DeploymentCondition) map[
 
 // Patchers
 
-func patchDeploymentSpec(prefix string, pathPrefix string, d *schema.ResourceData) []PatchOperation {
+func patchDeploymentSpec(prefix string, pathPrefix string, d resourceChanges) ([]PatchOperation, error) {
 	ops := make([]PatchOperation, 0)
 
 	if d.HasChange(prefix + "min_ready_seconds") {
@@ -324,10 +299,12 @@ func patchDeploymentSpec(prefix string, pathPrefix string, d *schema.ResourceDat
 			Value: d.Get(prefix + "revision_history_limit").(int),
 		})
 	}
-	if d.HasChange(prefix + "rollback_to") {
-		ops = append(ops, &ReplaceOperation{
+	// rollbackTo is cleared once the rollback is done, so only a newly
+	// requested revision results in an operation
+	if v, ok := d.Get(prefix + "rollback_to").([]interface{}); ok && len(v) > 0 && d.HasChange(prefix+"rollback_to") {
+		ops = append(ops, &AddOperation{
 			Path:  pathPrefix + "/rollbackTo",
-			Value: expandRollbackToReferance(d.Get(prefix + "rollback_to").([]interface{})),
+			Value: expandRollbackToReferance(v),
 		})
 	}
 	if d.HasChange(prefix + "selector") {
@@ -343,11 +320,11 @@ func patchDeploymentSpec(prefix string, pathPrefix string, d *schema.ResourceDat
 		})
 	}
 	if d.HasChange(prefix + "template") {
-		value, _ := expandTemplateReferance(d.Get(prefix + "template").([]interface{}))
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "/template",
-			Value: value,
-		})
+		diffOps, err := patchTemplateReferance(prefix+"template", pathPrefix+"/template", d)
+		if err != nil {
+			return ops, err
+		}
+		ops = append(ops, diffOps...)
 	}
-	return ops
+	return ops, nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	v1 "k8s.io/kubernetes/pkg/api/v1"
 	ex_v1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
 )
@@ -79,20 +80,43 @@ func TestExpandDeploymentSpec(t *testing.T) {
 			},
 			Expected: ex_v1beta1.DeploymentSpec{
 				Replicas:                ptrToInt32(int32(7)),
-				Selector:                &metav1.LabelSelector{},
 				Template:                v1.PodTemplateSpec{},
 				Strategy:                ex_v1beta1.DeploymentStrategy{},
 				MinReadySeconds:         int32(10),
 				RevisionHistoryLimit:    ptrToInt32(int32(6)),
 				Paused:                  false,
-				RollbackTo:              &ex_v1beta1.RollbackConfig{},
 				ProgressDeadlineSeconds: ptrToInt32(int32(5)),
 			},
 		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"min_ready_seconds":         0,
+					"paused":                    false,
+					"progress_deadline_seconds": 600,
+					"replicas":                  1,
+					"revision_history_limit":    0,
+					"selector":                  []interface{}{},
+					"template":                  []interface{}{},
+					"rollback_to":               []interface{}{},
+					"strategy":                  []interface{}{},
+				},
+			},
+			Expected: ex_v1beta1.DeploymentSpec{
+				Replicas:                ptrToInt32(int32(1)),
+				Template:                v1.PodTemplateSpec{},
+				Strategy:                ex_v1beta1.DeploymentStrategy{},
+				RevisionHistoryLimit:    ptrToInt32(int32(0)),
+				ProgressDeadlineSeconds: ptrToInt32(int32(600)),
+			},
+		},
 	}
 
 	for _, tc := range cases {
-		output := expandDeploymentSpec(tc.Input)
+		output, err := expandDeploymentSpec(tc.Input)
+		if err != nil {
+			t.Fatal(err)
+		}
 		if !reflect.DeepEqual(output, tc.Expected) {
 			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
 				tc.Expected, output)
@@ -147,3 +171,121 @@ func TestExpandSelectorReferance(t *testing.T) {
 		}
 	}
 }
+
+func TestFlattenDeploymentSpec(t *testing.T) {
+	cases := []struct {
+		Input    ex_v1beta1.DeploymentSpec
+		Expected map[string]interface{}
+	}{
+		{
+			Input: ex_v1beta1.DeploymentSpec{
+				Replicas: ptrToInt32(int32(3)),
+				Strategy: ex_v1beta1.DeploymentStrategy{
+					Type: ex_v1beta1.RecreateDeploymentStrategyType,
+				},
+				ProgressDeadlineSeconds: ptrToInt32(int32(600)),
+			},
+			Expected: map[string]interface{}{
+				"replicas":                  int32(3),
+				"min_ready_seconds":         int32(0),
+				"paused":                    false,
+				"progress_deadline_seconds": int32(600),
+				"strategy": []interface{}{
+					map[string]interface{}{
+						"type": "Recreate",
+					},
+				},
+			},
+		},
+		{
+			Input: ex_v1beta1.DeploymentSpec{
+				Strategy: ex_v1beta1.DeploymentStrategy{
+					Type: ex_v1beta1.RollingUpdateDeploymentStrategyType,
+					RollingUpdate: &ex_v1beta1.RollingUpdateDeployment{
+						MaxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+						MaxSurge:       &intstr.IntOrString{Type: intstr.Int, IntVal: 2},
+					},
+				},
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "nginx"},
+				},
+			},
+			Expected: map[string]interface{}{
+				"min_ready_seconds": int32(0),
+				"paused":            false,
+				"selector": []interface{}{
+					map[string]interface{}{
+						"match_labels": map[string]string{"app": "nginx"},
+					},
+				},
+				"strategy": []interface{}{
+					map[string]interface{}{
+						"type": "RollingUpdate",
+						"rolling_update": []interface{}{
+							map[string]interface{}{
+								"max_unavailable": "25%",
+								"max_surge":       "2",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := flattenDeploymentSpec(tc.Input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := output[0].(map[string]interface{})
+		// The pod template is covered by the pod spec flattener tests
+		delete(m, "template")
+		if !reflect.DeepEqual(m, tc.Expected) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.Expected, m)
+		}
+	}
+}
+
+func TestFlattenDeploymentStatus(t *testing.T) {
+	in := ex_v1beta1.DeploymentStatus{
+		ObservedGeneration: 2,
+		Replicas:           3,
+		UpdatedReplicas:    3,
+		ReadyReplicas:      2,
+		AvailableReplicas:  2,
+		Conditions: []ex_v1beta1.DeploymentCondition{
+			{
+				Type:    ex_v1beta1.DeploymentProgressing,
+				Status:  v1.ConditionFalse,
+				Reason:  "ProgressDeadlineExceeded",
+				Message: "ReplicaSet \"foo\" has timed out progressing.",
+			},
+		},
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"observed_generation":  int64(2),
+			"replicas":             int32(3),
+			"updated_replicas":     int32(3),
+			"ready_replicas":       int32(2),
+			"available_replicas":   int32(2),
+			"unavailable_replicas": int32(0),
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":    "Progressing",
+					"status":  "False",
+					"reason":  "ProgressDeadlineExceeded",
+					"message": "ReplicaSet \"foo\" has timed out progressing.",
+				},
+			},
+		},
+	}
+
+	output := flattenDeploymentStatus(in)
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+			expected, output)
+	}
+}
@@ -0,0 +1,129 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/kubernetes/pkg/api/v1"
+)
+
+// Flatteners
+
+func flattenEndpointsSubsets(in []api.EndpointSubset) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		// Nested sets have to be built explicitly, the SDK is unable to
+		// convert slices into sets below the top level.
+		m := make(map[string]interface{})
+		m["address"] = schema.NewSet(schema.HashResource(endpointsAddressSchema()), flattenEndpointsAddresses(n.Addresses))
+		m["not_ready_address"] = schema.NewSet(schema.HashResource(endpointsAddressSchema()), flattenEndpointsAddresses(n.NotReadyAddresses))
+		m["port"] = schema.NewSet(schema.HashResource(endpointsPortSchema()), flattenEndpointsPorts(n.Ports))
+		att[i] = m
+	}
+	return att
+}
+
+func flattenEndpointsAddresses(in []api.EndpointAddress) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		m["ip"] = n.IP
+		if n.Hostname != "" {
+			m["hostname"] = n.Hostname
+		}
+		if n.NodeName != nil {
+			m["node_name"] = *n.NodeName
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenEndpointsPorts(in []api.EndpointPort) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		if n.Name != "" {
+			m["name"] = n.Name
+		}
+		m["port"] = int(n.Port)
+		m["protocol"] = string(n.Protocol)
+		att[i] = m
+	}
+	return att
+}
+
+// Expanders
+
+func expandEndpointsSubsets(in []interface{}) []api.EndpointSubset {
+	if len(in) == 0 {
+		return []api.EndpointSubset{}
+	}
+	subsets := make([]api.EndpointSubset, len(in), len(in))
+	for i, s := range in {
+		m := s.(map[string]interface{})
+		if v, ok := m["address"].(*schema.Set); ok {
+			subsets[i].Addresses = expandEndpointsAddresses(v.List())
+		}
+		if v, ok := m["not_ready_address"].(*schema.Set); ok {
+			subsets[i].NotReadyAddresses = expandEndpointsAddresses(v.List())
+		}
+		if v, ok := m["port"].(*schema.Set); ok {
+			subsets[i].Ports = expandEndpointsPorts(v.List())
+		}
+	}
+	return subsets
+}
+
+func expandEndpointsAddresses(in []interface{}) []api.EndpointAddress {
+	if len(in) == 0 {
+		return nil
+	}
+	addresses := make([]api.EndpointAddress, len(in), len(in))
+	for i, a := range in {
+		m := a.(map[string]interface{})
+		if v, ok := m["ip"].(string); ok {
+			addresses[i].IP = v
+		}
+		if v, ok := m["hostname"].(string); ok {
+			addresses[i].Hostname = v
+		}
+		if v, ok := m["node_name"].(string); ok && v != "" {
+			addresses[i].NodeName = ptrToString(v)
+		}
+	}
+	return addresses
+}
+
+func expandEndpointsPorts(in []interface{}) []api.EndpointPort {
+	if len(in) == 0 {
+		return nil
+	}
+	ports := make([]api.EndpointPort, len(in), len(in))
+	for i, p := range in {
+		m := p.(map[string]interface{})
+		if v, ok := m["name"].(string); ok {
+			ports[i].Name = v
+		}
+		if v, ok := m["port"].(int); ok {
+			ports[i].Port = int32(v)
+		}
+		if v, ok := m["protocol"].(string); ok {
+			ports[i].Protocol = api.Protocol(v)
+		}
+	}
+	return ports
+}
+
+// Patch Ops
+
+func patchEndpointsSubsets(keyPrefix, pathPrefix string, d resourceChanges) PatchOperations {
+	ops := make([]PatchOperation, 0)
+	// Subsets are stored as a set, so there is no stable index to patch
+	// individual entries by. The whole list is replaced instead.
+	if d.HasChange(keyPrefix + "subset") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  pathPrefix + "subsets",
+			Value: expandEndpointsSubsets(d.Get(keyPrefix + "subset").(*schema.Set).List()),
+		})
+	}
+	return ops
+}
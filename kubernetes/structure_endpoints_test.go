@@ -0,0 +1,84 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/kubernetes/pkg/api/v1"
+)
+
+func TestExpandFlattenEndpointsSubsets(t *testing.T) {
+	subsets := []api.EndpointSubset{
+		{
+			Addresses: []api.EndpointAddress{
+				{IP: "10.0.0.4", Hostname: "db-0", NodeName: ptrToString("node-1")},
+			},
+			NotReadyAddresses: []api.EndpointAddress{
+				{IP: "10.0.0.5"},
+			},
+			Ports: []api.EndpointPort{
+				{Name: "postgres", Port: 5432, Protocol: api.ProtocolTCP},
+			},
+		},
+		{
+			Addresses: []api.EndpointAddress{
+				{IP: "10.0.1.4"},
+			},
+			Ports: []api.EndpointPort{
+				{Name: "dns", Port: 53, Protocol: api.ProtocolUDP},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceKubernetesEndpoints().Schema, map[string]interface{}{})
+	if err := d.Set("subset", flattenEndpointsSubsets(subsets)); err != nil {
+		t.Fatal(err)
+	}
+	expanded := expandEndpointsSubsets(d.Get("subset").(*schema.Set).List())
+	if len(expanded) != len(subsets) {
+		t.Fatalf("Expected %d subsets, got %d: %#v", len(subsets), len(expanded), expanded)
+	}
+	for _, want := range subsets {
+		found := false
+		for _, got := range expanded {
+			if reflect.DeepEqual(want, got) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("Subset %#v not found after round trip: %#v", want, expanded)
+		}
+	}
+
+	// The same subsets returned by the API in a different order must
+	// not produce a diff.
+	reversed := []api.EndpointSubset{subsets[1], subsets[0]}
+	reversed[0].Addresses = append(reversed[0].Addresses, api.EndpointAddress{IP: "10.0.1.5"})
+	subsets[1].Addresses = []api.EndpointAddress{{IP: "10.0.1.5"}, {IP: "10.0.1.4"}}
+
+	d1 := schema.TestResourceDataRaw(t, resourceKubernetesEndpoints().Schema, map[string]interface{}{})
+	if err := d1.Set("subset", flattenEndpointsSubsets(subsets)); err != nil {
+		t.Fatal(err)
+	}
+	d2 := schema.TestResourceDataRaw(t, resourceKubernetesEndpoints().Schema, map[string]interface{}{})
+	if err := d2.Set("subset", flattenEndpointsSubsets(reversed)); err != nil {
+		t.Fatal(err)
+	}
+	s1 := d1.Get("subset").(*schema.Set)
+	s2 := d2.Get("subset").(*schema.Set)
+	if s1.Difference(s2).Len() != 0 || s2.Difference(s1).Len() != 0 {
+		t.Fatalf("Expected reordered subsets to be equal.\nFirst:  %#v\nSecond: %#v",
+			s1.List(), s2.List())
+	}
+}
+
+func TestExpandEndpointsSubsetsEmpty(t *testing.T) {
+	// An empty list, rather than nil, is required so that removing all
+	// subsets sends an explicit empty value to the API.
+	out := expandEndpointsSubsets([]interface{}{})
+	if out == nil || len(out) != 0 {
+		t.Fatalf("Expected an empty non-nil slice, got %#v", out)
+	}
+}
@@ -1,7 +1,8 @@
 package kubernetes
 
 import (
-	"github.com/hashicorp/terraform/helper/schema"
+	"strings"
+
 	api "k8s.io/kubernetes/pkg/apis/autoscaling/v1"
 )
 
@@ -73,33 +74,7 @@ func flattenCrossVersionObjectReference(ref api.CrossVersionObjectReference) []i
 	return []interface{}{m}
 }
 
-func patchHorizontalPodAutoscalerSpec(prefix string, pathPrefix string, d *schema.ResourceData) []PatchOperation {
-	ops := make([]PatchOperation, 0)
-
-	if d.HasChange(prefix + "max_replicas") {
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "/maxReplicas",
-			Value: d.Get(prefix + "max_replicas").(int),
-		})
-	}
-	if d.HasChange(prefix + "min_replicas") {
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "/minReplicas",
-			Value: d.Get(prefix + "min_replicas").(int),
-		})
-	}
-	if d.HasChange(prefix + "scale_target_ref") {
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "/scaleTargetRef",
-			Value: expandCrossVersionObjectReference(d.Get(prefix + "scale_target_ref").([]interface{})),
-		})
-	}
-	if d.HasChange(prefix + "target_cpu_utilization_percentage") {
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "/targetCPUUtilizationPercentage",
-			Value: d.Get(prefix + "target_cpu_utilization_percentage").(int),
-		})
-	}
-
-	return ops
+func patchHorizontalPodAutoscalerSpec(prefix string, pathPrefix string, d resourceChanges) (PatchOperations, error) {
+	o, n := d.GetChange(strings.TrimSuffix(prefix, ".0."))
+	return diffObjects(pathPrefix, expandHorizontalPodAutoscalerSpec(o.([]interface{})), expandHorizontalPodAutoscalerSpec(n.([]interface{})))
 }
@@ -0,0 +1,164 @@
+package kubernetes
+
+import (
+	"strings"
+
+	ex_v1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+// Expanders
+
+func expandIngressSpec(l []interface{}) ex_v1beta1.IngressSpec {
+	obj := ex_v1beta1.IngressSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["backend"].([]interface{}); ok && len(v) > 0 {
+		obj.Backend = expandIngressBackend(v)
+	}
+	if v, ok := in["rule"].([]interface{}); ok && len(v) > 0 {
+		obj.Rules = expandIngressRules(v)
+	}
+	if v, ok := in["tls"].([]interface{}); ok && len(v) > 0 {
+		obj.TLS = expandIngressTLS(v)
+	}
+
+	return obj
+}
+
+func expandIngressBackend(l []interface{}) *ex_v1beta1.IngressBackend {
+	if len(l) == 0 || l[0] == nil {
+		return &ex_v1beta1.IngressBackend{}
+	}
+	in := l[0].(map[string]interface{})
+	return &ex_v1beta1.IngressBackend{
+		ServiceName: in["service_name"].(string),
+		ServicePort: expandPort(in["service_port"].(string)),
+	}
+}
+
+func expandIngressRules(l []interface{}) []ex_v1beta1.IngressRule {
+	rules := make([]ex_v1beta1.IngressRule, 0, len(l))
+	for _, r := range l {
+		if r == nil {
+			continue
+		}
+		in := r.(map[string]interface{})
+		rule := ex_v1beta1.IngressRule{
+			Host: in["host"].(string),
+		}
+		if v, ok := in["http"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			http := v[0].(map[string]interface{})
+			paths := http["path"].([]interface{})
+			rule.HTTP = &ex_v1beta1.HTTPIngressRuleValue{
+				Paths: make([]ex_v1beta1.HTTPIngressPath, len(paths)),
+			}
+			for i, p := range paths {
+				path := p.(map[string]interface{})
+				rule.HTTP.Paths[i] = ex_v1beta1.HTTPIngressPath{
+					Path:    path["path"].(string),
+					Backend: *expandIngressBackend(path["backend"].([]interface{})),
+				}
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func expandIngressTLS(l []interface{}) []ex_v1beta1.IngressTLS {
+	tls := make([]ex_v1beta1.IngressTLS, 0, len(l))
+	for _, t := range l {
+		if t == nil {
+			continue
+		}
+		in := t.(map[string]interface{})
+		obj := ex_v1beta1.IngressTLS{
+			SecretName: in["secret_name"].(string),
+		}
+		if v, ok := in["hosts"].([]interface{}); ok && len(v) > 0 {
+			obj.Hosts = expandStringSlice(v)
+		}
+		tls = append(tls, obj)
+	}
+	return tls
+}
+
+// Flatteners
+
+func flattenIngressSpec(in ex_v1beta1.IngressSpec) []interface{} {
+	att := make(map[string]interface{})
+
+	if in.Backend != nil {
+		att["backend"] = flattenIngressBackend(*in.Backend)
+	}
+	if len(in.Rules) > 0 {
+		att["rule"] = flattenIngressRules(in.Rules)
+	}
+	if len(in.TLS) > 0 {
+		att["tls"] = flattenIngressTLS(in.TLS)
+	}
+
+	return []interface{}{att}
+}
+
+func flattenIngressBackend(in ex_v1beta1.IngressBackend) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"service_name": in.ServiceName,
+			"service_port": in.ServicePort.String(),
+		},
+	}
+}
+
+func flattenIngressRules(in []ex_v1beta1.IngressRule) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, r := range in {
+		m := map[string]interface{}{
+			"host": r.Host,
+		}
+		if r.HTTP != nil {
+			paths := make([]interface{}, len(r.HTTP.Paths))
+			for j, p := range r.HTTP.Paths {
+				paths[j] = map[string]interface{}{
+					"path":    p.Path,
+					"backend": flattenIngressBackend(p.Backend),
+				}
+			}
+			m["http"] = []interface{}{
+				map[string]interface{}{
+					"path": paths,
+				},
+			}
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenIngressTLS(in []ex_v1beta1.IngressTLS) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, t := range in {
+		m := map[string]interface{}{
+			"secret_name": t.SecretName,
+		}
+		if len(t.Hosts) > 0 {
+			hosts := make([]interface{}, len(t.Hosts))
+			for j, h := range t.Hosts {
+				hosts[j] = h
+			}
+			m["hosts"] = hosts
+		}
+		att[i] = m
+	}
+	return att
+}
+
+// Patchers
+
+func patchIngressSpec(keyPrefix, pathPrefix string, d resourceChanges) (PatchOperations, error) {
+	o, n := d.GetChange(strings.TrimSuffix(keyPrefix, ".0."))
+	return diffObjects(pathPrefix, expandIngressSpec(o.([]interface{})), expandIngressSpec(n.([]interface{})))
+}
@@ -0,0 +1,180 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ex_v1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+func TestExpandIngressSpec(t *testing.T) {
+	cases := []struct {
+		Input    []interface{}
+		Expected ex_v1beta1.IngressSpec
+	}{
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"backend": []interface{}{
+						map[string]interface{}{
+							"service_name": "default-http-backend",
+							"service_port": "80",
+						},
+					},
+				},
+			},
+			Expected: ex_v1beta1.IngressSpec{
+				Backend: &ex_v1beta1.IngressBackend{
+					ServiceName: "default-http-backend",
+					ServicePort: intstr.FromInt(80),
+				},
+			},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"backend": []interface{}{},
+					"rule": []interface{}{
+						map[string]interface{}{
+							"host": "example.com",
+							"http": []interface{}{
+								map[string]interface{}{
+									"path": []interface{}{
+										map[string]interface{}{
+											"path": "/api",
+											"backend": []interface{}{
+												map[string]interface{}{
+													"service_name": "api",
+													"service_port": "http",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"tls": []interface{}{
+						map[string]interface{}{
+							"hosts":       []interface{}{"example.com"},
+							"secret_name": "example-tls",
+						},
+					},
+				},
+			},
+			Expected: ex_v1beta1.IngressSpec{
+				Rules: []ex_v1beta1.IngressRule{
+					{
+						Host: "example.com",
+						IngressRuleValue: ex_v1beta1.IngressRuleValue{
+							HTTP: &ex_v1beta1.HTTPIngressRuleValue{
+								Paths: []ex_v1beta1.HTTPIngressPath{
+									{
+										Path: "/api",
+										Backend: ex_v1beta1.IngressBackend{
+											ServiceName: "api",
+											ServicePort: intstr.FromString("http"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				TLS: []ex_v1beta1.IngressTLS{
+					{
+						Hosts:      []string{"example.com"},
+						SecretName: "example-tls",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandIngressSpec(tc.Input)
+		if !reflect.DeepEqual(output, tc.Expected) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.Expected, output)
+		}
+
+		flattened := flattenIngressSpec(output)
+		if !reflect.DeepEqual(expandIngressSpec(flattened), tc.Expected) {
+			t.Fatalf("Flattened spec does not expand back to the original.\nExpected: %#v\nGiven:    %#v",
+				tc.Expected, flattened)
+		}
+	}
+}
+
+func TestPatchIngressSpec(t *testing.T) {
+	raw := func(servicePort string) map[string]interface{} {
+		return map[string]interface{}{
+			"metadata": []interface{}{
+				map[string]interface{}{"name": "test"},
+			},
+			"spec": []interface{}{
+				map[string]interface{}{
+					"rule": []interface{}{
+						map[string]interface{}{
+							"host": "example.com",
+							"http": []interface{}{
+								map[string]interface{}{
+									"path": []interface{}{
+										map[string]interface{}{
+											"path": "/api",
+											"backend": []interface{}{
+												map[string]interface{}{
+													"service_name": "api",
+													"service_port": servicePort,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	r := resourceKubernetesIngress()
+	old := schema.TestResourceDataRaw(t, r.Schema, raw("http"))
+	old.SetId("default/test")
+	state := old.State()
+
+	c, err := config.NewRawConfig(raw("https"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := r.Diff(state, terraform.NewResourceConfig(c))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ops PatchOperations
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		var err error
+		ops, err = patchIngressSpec("spec.0.", "/spec/", d)
+		return err
+	}
+	if _, err := r.Apply(state, diff, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the changed backend is patched rather than all the rules
+	expected := []PatchOperation{
+		&ReplaceOperation{
+			Path:  "/spec/rules/0/http/paths/0/backend/servicePort",
+			Value: "https",
+		},
+	}
+	if !ops.Equal(expected) {
+		t.Fatalf("Operations don't match.\nExpected: %v\nGiven:    %v\n", expected, ops)
+	}
+}
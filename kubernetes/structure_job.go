@@ -0,0 +1,86 @@
+package kubernetes
+
+import (
+	batch_v1 "k8s.io/kubernetes/pkg/apis/batch/v1"
+)
+
+// Labels added by the job controller to the pod template
+var jobControllerLabels = []string{"controller-uid", "job-name"}
+
+// Expanders
+
+func expandJobSpec(l []interface{}) (batch_v1.JobSpec, error) {
+	obj := batch_v1.JobSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["active_deadline_seconds"].(int); ok && v > 0 {
+		obj.ActiveDeadlineSeconds = ptrToInt64(int64(v))
+	}
+	obj.Completions = ptrToInt32(int32(in["completions"].(int)))
+	obj.Parallelism = ptrToInt32(int32(in["parallelism"].(int)))
+
+	template, err := expandTemplateReferance(in["template"].([]interface{}))
+	if err != nil {
+		return obj, err
+	}
+	obj.Template = template
+
+	return obj, nil
+}
+
+// Flatteners
+
+func flattenJobSpec(in batch_v1.JobSpec) ([]interface{}, error) {
+	att := make(map[string]interface{})
+
+	if in.ActiveDeadlineSeconds != nil {
+		att["active_deadline_seconds"] = *in.ActiveDeadlineSeconds
+	}
+	if in.Completions != nil {
+		att["completions"] = *in.Completions
+	}
+	if in.Parallelism != nil {
+		att["parallelism"] = *in.Parallelism
+	}
+
+	for _, k := range jobControllerLabels {
+		delete(in.Template.ObjectMeta.Labels, k)
+	}
+	template, err := flattenTemplateReferance(in.Template)
+	if err != nil {
+		return nil, err
+	}
+	att["template"] = template
+
+	return []interface{}{att}, nil
+}
+
+// Patchers
+
+func patchJobSpec(prefix string, pathPrefix string, d resourceChanges) []PatchOperation {
+	ops := make([]PatchOperation, 0)
+
+	if d.HasChange(prefix + "active_deadline_seconds") {
+		v := d.Get(prefix + "active_deadline_seconds").(int)
+		if v > 0 {
+			ops = append(ops, &AddOperation{
+				Path:  pathPrefix + "/activeDeadlineSeconds",
+				Value: v,
+			})
+		} else {
+			ops = append(ops, &RemoveOperation{
+				Path: pathPrefix + "/activeDeadlineSeconds",
+			})
+		}
+	}
+	if d.HasChange(prefix + "parallelism") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  pathPrefix + "/parallelism",
+			Value: d.Get(prefix + "parallelism").(int),
+		})
+	}
+	return ops
+}
@@ -0,0 +1,143 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/api/v1"
+	batch_v1 "k8s.io/kubernetes/pkg/apis/batch/v1"
+)
+
+func TestExpandJobSpec(t *testing.T) {
+	cases := []struct {
+		Input    []interface{}
+		Expected batch_v1.JobSpec
+	}{
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"active_deadline_seconds": 0,
+					"completions":             3,
+					"parallelism":             2,
+					"template":                []interface{}{},
+				},
+			},
+			Expected: batch_v1.JobSpec{
+				Completions: ptrToInt32(int32(3)),
+				Parallelism: ptrToInt32(int32(2)),
+			},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"active_deadline_seconds": 120,
+					"completions":             1,
+					"parallelism":             1,
+					"template":                []interface{}{},
+				},
+			},
+			Expected: batch_v1.JobSpec{
+				ActiveDeadlineSeconds: ptrToInt64(int64(120)),
+				Completions:           ptrToInt32(int32(1)),
+				Parallelism:           ptrToInt32(int32(1)),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := expandJobSpec(tc.Input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(output, tc.Expected) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.Expected, output)
+		}
+	}
+}
+
+func TestFlattenJobSpec_removesControllerLabels(t *testing.T) {
+	in := batch_v1.JobSpec{
+		Completions: ptrToInt32(int32(1)),
+		Parallelism: ptrToInt32(int32(1)),
+		Selector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"controller-uid": "abc"},
+		},
+		Template: v1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"app":            "migrations",
+					"controller-uid": "abc",
+					"job-name":       "migrate",
+				},
+			},
+		},
+	}
+
+	output, err := flattenJobSpec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := output[0].(map[string]interface{})
+	template := spec["template"].([]interface{})[0].(map[string]interface{})
+	labels := template["metadata"].([]map[string]interface{})[0]["labels"]
+	expected := map[string]string{"app": "migrations"}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Fatalf("Unexpected template labels.\nExpected: %#v\nGiven:    %#v", expected, labels)
+	}
+	if _, ok := spec["active_deadline_seconds"]; ok {
+		t.Fatalf("Expected active_deadline_seconds to be omitted, given %#v", spec["active_deadline_seconds"])
+	}
+}
+
+func TestJobSpecFields_forceNew(t *testing.T) {
+	fields := jobSpecFields(false)
+	if fields["parallelism"].ForceNew {
+		t.Fatal("Expected parallelism to be updatable")
+	}
+	if !fields["completions"].ForceNew {
+		t.Fatal("Expected completions to force a new job")
+	}
+	template := fields["template"].Elem.(*schema.Resource).Schema
+	podSpec := template["spec"].Elem.(*schema.Resource).Schema
+	container := podSpec["container"].Elem.(*schema.Resource).Schema
+	if !container["image"].ForceNew {
+		t.Fatal("Expected container image of the job template to force a new job")
+	}
+	labels := template["metadata"].Elem.(*schema.Resource).Schema["labels"]
+	if !labels.ForceNew {
+		t.Fatal("Expected labels of the job template to force a new job")
+	}
+
+	fields = jobSpecFields(true)
+	template = fields["template"].Elem.(*schema.Resource).Schema
+	podSpec = template["spec"].Elem.(*schema.Resource).Schema
+	container = podSpec["container"].Elem.(*schema.Resource).Schema
+	if container["image"].ForceNew {
+		t.Fatal("Expected container image of an updatable job template to be updatable")
+	}
+}
+
+func TestJobSpecFields_restartPolicy(t *testing.T) {
+	fields := jobSpecFields(false)
+	template := fields["template"].Elem.(*schema.Resource).Schema
+	podSpec := template["spec"].Elem.(*schema.Resource).Schema
+	restartPolicy := podSpec["restart_policy"]
+	if restartPolicy.Default != "Never" {
+		t.Fatalf("Expected restart_policy of the job template to default to Never, got %v", restartPolicy.Default)
+	}
+	for _, v := range []string{"OnFailure", "Never"} {
+		if _, es := restartPolicy.ValidateFunc(v, "restart_policy"); len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %v", v, es)
+		}
+	}
+	if _, es := restartPolicy.ValidateFunc("Always", "restart_policy"); len(es) == 0 {
+		t.Fatal("Expected Always to be invalid for a job")
+	}
+
+	if podSpecFields(false)["restart_policy"].Default != "Always" {
+		t.Fatal("Expected restart_policy of pods to keep defaulting to Always")
+	}
+}
@@ -0,0 +1,154 @@
+package kubernetes
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/api/v1"
+	ex_v1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+// Expanders
+
+func expandNetworkPolicySpec(l []interface{}) ex_v1beta1.NetworkPolicySpec {
+	obj := ex_v1beta1.NetworkPolicySpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.PodSelector = *expandLabelSelector(in["pod_selector"].([]interface{}))
+	if v, ok := in["ingress"].([]interface{}); ok && len(v) > 0 {
+		obj.Ingress = expandNetworkPolicyIngress(v)
+	}
+
+	return obj
+}
+
+func expandNetworkPolicyIngress(l []interface{}) []ex_v1beta1.NetworkPolicyIngressRule {
+	rules := make([]ex_v1beta1.NetworkPolicyIngressRule, len(l))
+	for i, r := range l {
+		if r == nil {
+			continue
+		}
+		in := r.(map[string]interface{})
+		if v, ok := in["ports"].([]interface{}); ok && len(v) > 0 {
+			rules[i].Ports = expandNetworkPolicyPorts(v)
+		}
+		if v, ok := in["from"].([]interface{}); ok && len(v) > 0 {
+			rules[i].From = expandNetworkPolicyPeers(v)
+		}
+	}
+	return rules
+}
+
+func expandNetworkPolicyPorts(l []interface{}) []ex_v1beta1.NetworkPolicyPort {
+	ports := make([]ex_v1beta1.NetworkPolicyPort, len(l))
+	for i, p := range l {
+		if p == nil {
+			continue
+		}
+		in := p.(map[string]interface{})
+		if v, ok := in["port"].(string); ok && v != "" {
+			port := expandPort(v)
+			ports[i].Port = &port
+		}
+		if v, ok := in["protocol"].(string); ok && v != "" {
+			protocol := v1.Protocol(v)
+			ports[i].Protocol = &protocol
+		}
+	}
+	return ports
+}
+
+func expandNetworkPolicyPeers(l []interface{}) []ex_v1beta1.NetworkPolicyPeer {
+	peers := make([]ex_v1beta1.NetworkPolicyPeer, len(l))
+	for i, p := range l {
+		if p == nil {
+			continue
+		}
+		in := p.(map[string]interface{})
+		if v, ok := in["namespace_selector"].([]interface{}); ok && len(v) > 0 {
+			peers[i].NamespaceSelector = expandLabelSelector(v)
+		}
+		if v, ok := in["pod_selector"].([]interface{}); ok && len(v) > 0 {
+			peers[i].PodSelector = expandLabelSelector(v)
+		}
+	}
+	return peers
+}
+
+// Flatteners
+
+func flattenNetworkPolicySpec(in ex_v1beta1.NetworkPolicySpec) []interface{} {
+	att := make(map[string]interface{})
+
+	att["pod_selector"] = flattenNetworkPolicySelector(&in.PodSelector)
+	if len(in.Ingress) > 0 {
+		att["ingress"] = flattenNetworkPolicyIngress(in.Ingress)
+	}
+
+	return []interface{}{att}
+}
+
+// flattenNetworkPolicySelector keeps a block for empty selectors,
+// which are meaningful here as they select everything.
+func flattenNetworkPolicySelector(in *metav1.LabelSelector) []interface{} {
+	att := flattenLabelSelector(in)
+	if len(att) == 0 {
+		return []interface{}{map[string]interface{}{}}
+	}
+	return att
+}
+
+func flattenNetworkPolicyIngress(in []ex_v1beta1.NetworkPolicyIngressRule) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, r := range in {
+		m := make(map[string]interface{})
+		if len(r.Ports) > 0 {
+			m["ports"] = flattenNetworkPolicyPorts(r.Ports)
+		}
+		if len(r.From) > 0 {
+			m["from"] = flattenNetworkPolicyPeers(r.From)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenNetworkPolicyPorts(in []ex_v1beta1.NetworkPolicyPort) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, p := range in {
+		m := make(map[string]interface{})
+		if p.Port != nil {
+			m["port"] = p.Port.String()
+		}
+		if p.Protocol != nil {
+			m["protocol"] = string(*p.Protocol)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenNetworkPolicyPeers(in []ex_v1beta1.NetworkPolicyPeer) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, p := range in {
+		m := make(map[string]interface{})
+		if p.NamespaceSelector != nil {
+			m["namespace_selector"] = flattenNetworkPolicySelector(p.NamespaceSelector)
+		}
+		if p.PodSelector != nil {
+			m["pod_selector"] = flattenNetworkPolicySelector(p.PodSelector)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+// Patchers
+
+func patchNetworkPolicySpec(keyPrefix, pathPrefix string, d resourceChanges) (PatchOperations, error) {
+	o, n := d.GetChange(strings.TrimSuffix(keyPrefix, ".0."))
+	return diffObjects(pathPrefix, expandNetworkPolicySpec(o.([]interface{})), expandNetworkPolicySpec(n.([]interface{})))
+}
@@ -0,0 +1,148 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/api/v1"
+	ex_v1beta1 "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+)
+
+func TestExpandFlattenNetworkPolicySpec(t *testing.T) {
+	tcp := v1.ProtocolTCP
+	udp := v1.ProtocolUDP
+	port := intstr.FromInt(6379)
+	namedPort := intstr.FromString("dns")
+
+	cases := []struct {
+		Input    []interface{}
+		Expected ex_v1beta1.NetworkPolicySpec
+	}{
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"pod_selector": []interface{}{
+						map[string]interface{}{},
+					},
+				},
+			},
+			Expected: ex_v1beta1.NetworkPolicySpec{},
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"pod_selector": []interface{}{
+						map[string]interface{}{
+							"match_labels": map[string]interface{}{
+								"role": "db",
+							},
+						},
+					},
+					"ingress": []interface{}{
+						map[string]interface{}{
+							"from": []interface{}{
+								map[string]interface{}{
+									"namespace_selector": []interface{}{
+										map[string]interface{}{
+											"match_labels": map[string]interface{}{
+												"project": "myproject",
+											},
+										},
+									},
+								},
+								map[string]interface{}{
+									"pod_selector": []interface{}{
+										map[string]interface{}{
+											"match_expressions": []interface{}{
+												map[string]interface{}{
+													"key":      "role",
+													"operator": "In",
+													"values":   newStringSet(schema.HashString, []string{"frontend", "backend"}),
+												},
+											},
+										},
+									},
+								},
+							},
+							"ports": []interface{}{
+								map[string]interface{}{
+									"port":     "6379",
+									"protocol": "TCP",
+								},
+								map[string]interface{}{
+									"port":     "dns",
+									"protocol": "UDP",
+								},
+							},
+						},
+						map[string]interface{}{},
+					},
+				},
+			},
+			Expected: ex_v1beta1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"role": "db"},
+				},
+				Ingress: []ex_v1beta1.NetworkPolicyIngressRule{
+					{
+						From: []ex_v1beta1.NetworkPolicyPeer{
+							{
+								NamespaceSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"project": "myproject"},
+								},
+							},
+							{
+								PodSelector: &metav1.LabelSelector{
+									MatchExpressions: []metav1.LabelSelectorRequirement{
+										{
+											Key:      "role",
+											Operator: metav1.LabelSelectorOpIn,
+											Values:   []string{"backend", "frontend"},
+										},
+									},
+								},
+							},
+						},
+						Ports: []ex_v1beta1.NetworkPolicyPort{
+							{
+								Port:     &port,
+								Protocol: &tcp,
+							},
+							{
+								Port:     &namedPort,
+								Protocol: &udp,
+							},
+						},
+					},
+					{},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandNetworkPolicySpec(tc.Input)
+		if !reflect.DeepEqual(output, tc.Expected) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.Expected, output)
+		}
+
+		flattened := flattenNetworkPolicySpec(output)
+		if len(flattened[0].(map[string]interface{})["pod_selector"].([]interface{})) != 1 {
+			t.Fatalf("Expected pod_selector block to be kept: %#v", flattened)
+		}
+		// Pass the flattened spec through the schema, as Terraform does
+		d := schema.TestResourceDataRaw(t, resourceKubernetesNetworkPolicy().Schema, map[string]interface{}{})
+		if err := d.Set("spec", flattened); err != nil {
+			t.Fatal(err)
+		}
+		roundTrip := expandNetworkPolicySpec(d.Get("spec").([]interface{}))
+		if !reflect.DeepEqual(roundTrip, tc.Expected) {
+			t.Fatalf("Flattened spec does not expand back to the original.\nExpected: %#v\nGiven:    %#v",
+				tc.Expected, roundTrip)
+		}
+	}
+}
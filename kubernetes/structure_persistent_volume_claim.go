@@ -1,6 +1,8 @@
 package kubernetes
 
 import (
+	"sort"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kubernetes/pkg/api/v1"
@@ -27,7 +29,7 @@ func flattenLabelSelectorRequirement(in []metav1.LabelSelectorRequirement) []int
 	for i, n := range in {
 		m := make(map[string]interface{})
 		m["key"] = n.Key
-		m["operator"] = n.Operator
+		m["operator"] = string(n.Operator)
 		m["values"] = newStringSet(schema.HashString, n.Values)
 		att[i] = m
 	}
@@ -85,10 +87,12 @@ func expandLabelSelectorRequirement(l []interface{}) []metav1.LabelSelectorRequi
 	obj := make([]metav1.LabelSelectorRequirement, len(l), len(l))
 	for i, n := range l {
 		in := n.(map[string]interface{})
+		values := sliceOfString(in["values"].(*schema.Set).List())
+		sort.Strings(values)
 		obj[i] = metav1.LabelSelectorRequirement{
 			Key:      in["key"].(string),
 			Operator: metav1.LabelSelectorOperator(in["operator"].(string)),
-			Values:   sliceOfString(in["values"].(*schema.Set).List()),
+			Values:   values,
 		}
 	}
 	return obj
@@ -735,254 +735,31 @@ func expandVsphereVirtualDiskVolumeSource(l []interface{}) *v1.VsphereVirtualDis
 	return obj
 }
 
-func patchPersistentVolumeSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOperations, error) {
+func patchPersistentVolumeSpec(pathPrefix, prefix string, d resourceChanges) (PatchOperations, error) {
 	ops := make([]PatchOperation, 0)
-	prefix += ".0."
-
-	if d.HasChange(prefix + "capacity") {
-		v := d.Get(prefix + "capacity").(map[string]interface{})
-		capacity, err := expandMapToResourceList(v)
-		if err != nil {
-			return ops, err
-		}
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "/capacity",
-			Value: capacity,
-		})
+	o, n := d.GetChange(prefix)
+	oldSpec, err := expandPersistentVolumeSpec(o.([]interface{}))
+	if err != nil {
+		return ops, err
 	}
-
-	if d.HasChange(prefix + "persistent_volume_source") {
-		ops = append(ops, patchPersistentVolumeSource(
-			pathPrefix,
-			prefix+"persistent_volume_source.0.",
-			d,
-		)...)
+	newSpec, err := expandPersistentVolumeSpec(n.([]interface{}))
+	if err != nil {
+		return ops, err
 	}
 
-	if d.HasChange(prefix + "access_modes") {
-		v := d.Get(prefix + "access_modes").(*schema.Set)
+	// Access modes are a set, which has no stable order
+	// to address its items by, so it's replaced as a whole
+	if d.HasChange(prefix + ".0.access_modes") {
 		ops = append(ops, &ReplaceOperation{
 			Path:  pathPrefix + "/accessModes",
-			Value: expandPersistentVolumeAccessModes(v.List()),
-		})
-	}
-	if d.HasChange(prefix + "access_modes") {
-		v := d.Get(prefix + "persistent_volume_reclaim_policy").(string)
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "/persistentVolumeReclaimPolicy",
-			Value: v1.PersistentVolumeReclaimPolicy(v),
+			Value: newSpec.AccessModes,
 		})
 	}
+	oldSpec.AccessModes, newSpec.AccessModes = nil, nil
 
-	return ops, nil
-}
-
-func patchPersistentVolumeSource(pathPrefix, prefix string, d *schema.ResourceData) []PatchOperation {
-	ops := make([]PatchOperation, 0)
-
-	if d.HasChange(prefix + "gce_persistent_disk") {
-		oldIn, newIn := d.GetChange(prefix + "gce_persistent_disk")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/gcePersistentDisk",
-				Value: expandGCEPersistentDiskVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/gcePersistentDisk"})
-		}
-	}
-
-	if d.HasChange(prefix + "aws_elastic_block_store") {
-		oldIn, newIn := d.GetChange(prefix + "aws_elastic_block_store")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/awsElasticBlockStore",
-				Value: expandAWSElasticBlockStoreVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/awsElasticBlockStore"})
-		}
-	}
-
-	if d.HasChange(prefix + "host_path") {
-		oldIn, newIn := d.GetChange(prefix + "host_path")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/hostPath",
-				Value: expandHostPathVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/hostPath"})
-		}
-	}
-
-	if d.HasChange(prefix + "glusterfs") {
-		oldIn, newIn := d.GetChange(prefix + "glusterfs")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/glusterfs",
-				Value: expandGlusterfsVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/glusterfs"})
-		}
-	}
-
-	if d.HasChange(prefix + "nfs") {
-		oldIn, newIn := d.GetChange(prefix + "nfs")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/nfs",
-				Value: expandNFSVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/nfs"})
-		}
-	}
-
-	if d.HasChange(prefix + "rbd") {
-		oldIn, newIn := d.GetChange(prefix + "rbd")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/rbd",
-				Value: expandRBDVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/rbd"})
-		}
-	}
-
-	if d.HasChange(prefix + "iscsi") {
-		oldIn, newIn := d.GetChange(prefix + "iscsi")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/iscsi",
-				Value: expandISCSIVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/iscsi"})
-		}
+	diffOps, err := diffObjects(pathPrefix, oldSpec, newSpec)
+	if err != nil {
+		return ops, err
 	}
-
-	if d.HasChange(prefix + "cinder") {
-		oldIn, newIn := d.GetChange(prefix + "cinder")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/cinder",
-				Value: expandCinderVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/cinder"})
-		}
-	}
-
-	if d.HasChange(prefix + "ceph_fs") {
-		oldIn, newIn := d.GetChange(prefix + "ceph_fs")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/cephfs",
-				Value: expandCephFSVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/cephfs"})
-		}
-	}
-
-	if d.HasChange(prefix + "fc") {
-		oldIn, newIn := d.GetChange(prefix + "fc")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/fc",
-				Value: expandFCVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/fc"})
-		}
-	}
-
-	if d.HasChange(prefix + "flocker") {
-		oldIn, newIn := d.GetChange(prefix + "flocker")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/flocker",
-				Value: expandFlockerVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/flocker"})
-		}
-	}
-
-	if d.HasChange(prefix + "flex_volume") {
-		oldIn, newIn := d.GetChange(prefix + "flex_volume")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/flexVolume",
-				Value: expandFlexVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/flexVolume"})
-		}
-	}
-
-	if d.HasChange(prefix + "azure_file") {
-		oldIn, newIn := d.GetChange(prefix + "azure_file")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/azureFile",
-				Value: expandAzureFileVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/azureFile"})
-		}
-	}
-
-	if d.HasChange(prefix + "vsphere_volume") {
-		oldIn, newIn := d.GetChange(prefix + "vsphere_volume")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/vsphereVolume",
-				Value: expandVsphereVirtualDiskVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/vsphereVolume"})
-		}
-	}
-
-	if d.HasChange(prefix + "quobyte") {
-		oldIn, newIn := d.GetChange(prefix + "quobyte")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/quobyte",
-				Value: expandQuobyteVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/quobyte"})
-		}
-	}
-
-	if d.HasChange(prefix + "azure_disk") {
-		oldIn, newIn := d.GetChange(prefix + "azure_disk")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/azureDisk",
-				Value: expandAzureDiskVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/azureDisk"})
-		}
-	}
-
-	if d.HasChange(prefix + "photon_persistent_disk") {
-		oldIn, newIn := d.GetChange(prefix + "photon_persistent_disk")
-		if v, ok := newIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/photonPersistentDisk",
-				Value: expandPhotonPersistentDiskVolumeSource(v),
-			})
-		} else if v, ok := oldIn.([]interface{}); ok && len(v) > 0 {
-			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/photonPersistentDisk"})
-		}
-	}
-
-	return ops
+	return append(ops, diffOps...), nil
 }
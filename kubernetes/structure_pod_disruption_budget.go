@@ -0,0 +1,42 @@
+package kubernetes
+
+import (
+	policy "k8s.io/kubernetes/pkg/apis/policy/v1beta1"
+)
+
+// Flatteners
+
+func flattenPodDisruptionBudgetSpec(in policy.PodDisruptionBudgetSpec) []interface{} {
+	att := make(map[string]interface{})
+	att["min_available"] = in.MinAvailable.String()
+	if in.Selector != nil {
+		att["selector"] = flattenLabelSelector(in.Selector)
+	}
+	return []interface{}{att}
+}
+
+func flattenPodDisruptionBudgetStatus(in policy.PodDisruptionBudgetStatus) []interface{} {
+	att := make(map[string]interface{})
+	att["current_healthy"] = int(in.CurrentHealthy)
+	att["desired_healthy"] = int(in.DesiredHealthy)
+	att["disruptions_allowed"] = int(in.PodDisruptionsAllowed)
+	att["expected_pods"] = int(in.ExpectedPods)
+	return []interface{}{att}
+}
+
+// Expanders
+
+func expandPodDisruptionBudgetSpec(l []interface{}) policy.PodDisruptionBudgetSpec {
+	if len(l) == 0 || l[0] == nil {
+		return policy.PodDisruptionBudgetSpec{}
+	}
+	in := l[0].(map[string]interface{})
+	obj := policy.PodDisruptionBudgetSpec{}
+	if v, ok := in["min_available"].(string); ok && v != "" {
+		obj.MinAvailable = expandPort(v)
+	}
+	if v, ok := in["selector"].([]interface{}); ok && len(v) > 0 {
+		obj.Selector = expandLabelSelector(v)
+	}
+	return obj
+}
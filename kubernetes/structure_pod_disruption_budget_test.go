@@ -0,0 +1,87 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	policy "k8s.io/kubernetes/pkg/apis/policy/v1beta1"
+)
+
+func TestExpandFlattenPodDisruptionBudgetSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput policy.PodDisruptionBudgetSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"min_available": "2",
+					"selector": []interface{}{
+						map[string]interface{}{
+							"match_labels": map[string]interface{}{
+								"app": "zookeeper",
+							},
+						},
+					},
+				},
+			},
+			policy.PodDisruptionBudgetSpec{
+				MinAvailable: intstr.FromInt(2),
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"app": "zookeeper",
+					},
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"min_available": "60%",
+					"selector": []interface{}{
+						map[string]interface{}{
+							"match_expressions": []interface{}{
+								map[string]interface{}{
+									"key":      "role",
+									"operator": "In",
+									"values":   newStringSet(schema.HashString, []string{"etcd"}),
+								},
+							},
+						},
+					},
+				},
+			},
+			policy.PodDisruptionBudgetSpec{
+				MinAvailable: intstr.FromString("60%"),
+				Selector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{
+							Key:      "role",
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{"etcd"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		output := expandPodDisruptionBudgetSpec(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Case %d: unexpected expanded output.\nExpected: %#v\nGiven:    %#v", i, tc.ExpectedOutput, output)
+		}
+
+		d := schema.TestResourceDataRaw(t, resourceKubernetesPodDisruptionBudget().Schema, map[string]interface{}{})
+		if err := d.Set("spec", flattenPodDisruptionBudgetSpec(output)); err != nil {
+			t.Fatal(err)
+		}
+		roundTrip := expandPodDisruptionBudgetSpec(d.Get("spec").([]interface{}))
+		if !reflect.DeepEqual(roundTrip, tc.ExpectedOutput) {
+			t.Fatalf("Case %d: unexpected round trip output.\nExpected: %#v\nGiven:    %#v", i, tc.ExpectedOutput, roundTrip)
+		}
+	}
+}
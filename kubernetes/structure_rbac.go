@@ -0,0 +1,132 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	rbac_v1beta1 "k8s.io/kubernetes/pkg/apis/rbac/v1beta1"
+)
+
+// Expanders
+
+func expandRBACPolicyRules(l []interface{}) []rbac_v1beta1.PolicyRule {
+	rules := make([]rbac_v1beta1.PolicyRule, 0, len(l))
+	for _, r := range l {
+		if r == nil {
+			continue
+		}
+		in := r.(map[string]interface{})
+		rule := rbac_v1beta1.PolicyRule{}
+		if v, ok := in["api_groups"].(*schema.Set); ok && v.Len() > 0 {
+			rule.APIGroups = sliceOfString(v.List())
+		}
+		if v, ok := in["non_resource_urls"].(*schema.Set); ok && v.Len() > 0 {
+			rule.NonResourceURLs = sliceOfString(v.List())
+		}
+		if v, ok := in["resource_names"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ResourceNames = sliceOfString(v.List())
+		}
+		if v, ok := in["resources"].(*schema.Set); ok && v.Len() > 0 {
+			rule.Resources = sliceOfString(v.List())
+		}
+		if v, ok := in["verbs"].(*schema.Set); ok {
+			rule.Verbs = sliceOfString(v.List())
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func expandRBACRoleRef(l []interface{}) rbac_v1beta1.RoleRef {
+	if len(l) == 0 || l[0] == nil {
+		return rbac_v1beta1.RoleRef{}
+	}
+	in := l[0].(map[string]interface{})
+	return rbac_v1beta1.RoleRef{
+		APIGroup: in["api_group"].(string),
+		Kind:     in["kind"].(string),
+		Name:     in["name"].(string),
+	}
+}
+
+func expandRBACSubjects(l []interface{}) []rbac_v1beta1.Subject {
+	subjects := make([]rbac_v1beta1.Subject, 0, len(l))
+	for _, s := range l {
+		if s == nil {
+			continue
+		}
+		in := s.(map[string]interface{})
+		subject := rbac_v1beta1.Subject{
+			Kind: in["kind"].(string),
+			Name: in["name"].(string),
+		}
+		if v, ok := in["api_group"].(string); ok {
+			subject.APIGroup = v
+		}
+		if v, ok := in["namespace"].(string); ok {
+			subject.Namespace = v
+		}
+		subjects = append(subjects, subject)
+	}
+	return subjects
+}
+
+// Flatteners
+
+func flattenRBACPolicyRules(in []rbac_v1beta1.PolicyRule) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, r := range in {
+		m := make(map[string]interface{})
+		if len(r.APIGroups) > 0 {
+			m["api_groups"] = newStringSet(schema.HashString, r.APIGroups)
+		}
+		if len(r.NonResourceURLs) > 0 {
+			m["non_resource_urls"] = newStringSet(schema.HashString, r.NonResourceURLs)
+		}
+		if len(r.ResourceNames) > 0 {
+			m["resource_names"] = newStringSet(schema.HashString, r.ResourceNames)
+		}
+		if len(r.Resources) > 0 {
+			m["resources"] = newStringSet(schema.HashString, r.Resources)
+		}
+		m["verbs"] = newStringSet(schema.HashString, r.Verbs)
+		att[i] = m
+	}
+	return att
+}
+
+func flattenRBACRoleRef(in rbac_v1beta1.RoleRef) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"api_group": in.APIGroup,
+			"kind":      in.Kind,
+			"name":      in.Name,
+		},
+	}
+}
+
+func flattenRBACSubjects(in []rbac_v1beta1.Subject) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, s := range in {
+		m := map[string]interface{}{
+			"api_group": s.APIGroup,
+			"kind":      s.Kind,
+			"name":      s.Name,
+		}
+		if s.Namespace != "" {
+			m["namespace"] = s.Namespace
+		}
+		att[i] = m
+	}
+	return att
+}
+
+// Patchers
+
+func patchRBACPolicyRules(keyPrefix, pathPrefix string, d resourceChanges) (PatchOperations, error) {
+	o, n := d.GetChange(keyPrefix + "rule")
+	return diffObjects(pathPrefix+"rules", expandRBACPolicyRules(o.([]interface{})), expandRBACPolicyRules(n.([]interface{})))
+}
+
+func patchRBACSubjects(keyPrefix, pathPrefix string, d resourceChanges) (PatchOperations, error) {
+	o, n := d.GetChange(keyPrefix + "subject")
+	return diffObjects(pathPrefix+"subjects", expandRBACSubjects(o.([]interface{})), expandRBACSubjects(n.([]interface{})))
+}
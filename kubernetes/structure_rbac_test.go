@@ -0,0 +1,141 @@
+package kubernetes
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	rbac_v1beta1 "k8s.io/kubernetes/pkg/apis/rbac/v1beta1"
+)
+
+func TestExpandRBACPolicyRules(t *testing.T) {
+	cases := []struct {
+		Input    []interface{}
+		Expected []rbac_v1beta1.PolicyRule
+	}{
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"api_groups":        newStringSet(schema.HashString, []string{""}),
+					"non_resource_urls": newStringSet(schema.HashString, []string{}),
+					"resource_names":    newStringSet(schema.HashString, []string{"foo"}),
+					"resources":         newStringSet(schema.HashString, []string{"pods"}),
+					"verbs":             newStringSet(schema.HashString, []string{"get"}),
+				},
+				map[string]interface{}{
+					"api_groups":        newStringSet(schema.HashString, []string{}),
+					"non_resource_urls": newStringSet(schema.HashString, []string{"/healthz"}),
+					"resource_names":    newStringSet(schema.HashString, []string{}),
+					"resources":         newStringSet(schema.HashString, []string{}),
+					"verbs":             newStringSet(schema.HashString, []string{"get"}),
+				},
+			},
+			Expected: []rbac_v1beta1.PolicyRule{
+				{
+					APIGroups:     []string{""},
+					ResourceNames: []string{"foo"},
+					Resources:     []string{"pods"},
+					Verbs:         []string{"get"},
+				},
+				{
+					NonResourceURLs: []string{"/healthz"},
+					Verbs:           []string{"get"},
+				},
+			},
+		},
+		{
+			Input:    []interface{}{},
+			Expected: []rbac_v1beta1.PolicyRule{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandRBACPolicyRules(tc.Input)
+		if !reflect.DeepEqual(output, tc.Expected) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.Expected, output)
+		}
+	}
+}
+
+func TestFlattenRBACPolicyRules(t *testing.T) {
+	in := []rbac_v1beta1.PolicyRule{
+		{
+			APIGroups: []string{"", "extensions"},
+			Resources: []string{"deployments", "pods"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+	}
+
+	output := flattenRBACPolicyRules(in)
+	if len(output) != 1 {
+		t.Fatalf("Expected 1 rule, given %d", len(output))
+	}
+	rule := output[0].(map[string]interface{})
+
+	for k, expected := range map[string][]string{
+		"api_groups": {"", "extensions"},
+		"resources":  {"deployments", "pods"},
+		"verbs":      {"get", "list", "watch"},
+	} {
+		given := sliceOfString(rule[k].(*schema.Set).List())
+		sort.Strings(given)
+		if !reflect.DeepEqual(given, expected) {
+			t.Fatalf("Unexpected %s.\nExpected: %#v\nGiven:    %#v", k, expected, given)
+		}
+	}
+	for _, k := range []string{"non_resource_urls", "resource_names"} {
+		if _, ok := rule[k]; ok {
+			t.Fatalf("Expected %s to be omitted, given %#v", k, rule[k])
+		}
+	}
+}
+
+func TestExpandRBACSubjects(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"api_group": "",
+			"kind":      "ServiceAccount",
+			"name":      "deployer",
+			"namespace": "ci",
+		},
+		map[string]interface{}{
+			"api_group": "rbac.authorization.k8s.io",
+			"kind":      "User",
+			"name":      "jane",
+			"namespace": "",
+		},
+	}
+	expected := []rbac_v1beta1.Subject{
+		{
+			Kind:      "ServiceAccount",
+			Name:      "deployer",
+			Namespace: "ci",
+		},
+		{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "User",
+			Name:     "jane",
+		},
+	}
+
+	output := expandRBACSubjects(in)
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+			expected, output)
+	}
+
+	flattened := flattenRBACSubjects(output)
+	if !reflect.DeepEqual(flattened[0], map[string]interface{}{
+		"api_group": "",
+		"kind":      "ServiceAccount",
+		"name":      "deployer",
+		"namespace": "ci",
+	}) {
+		t.Fatalf("Unexpected output from flattener: %#v", flattened[0])
+	}
+	if _, ok := flattened[1].(map[string]interface{})["namespace"]; ok {
+		t.Fatalf("Expected namespace of user subject to be omitted: %#v", flattened[1])
+	}
+}
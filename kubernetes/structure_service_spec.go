@@ -1,15 +1,34 @@
 package kubernetes
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/kubernetes/pkg/api/v1"
 )
 
+// The vendored API predates the ServiceSpec fields for these settings,
+// so they are set via the annotations which the API server reads instead.
+// Those are only honoured by clusters which still support them.
+const (
+	serviceExternalTrafficAnnotation          = "service.beta.kubernetes.io/external-traffic"
+	serviceHealthCheckNodePortAnnotation      = "service.beta.kubernetes.io/healthcheck-nodeport"
+	serviceTolerateUnreadyEndpointsAnnotation = "service.alpha.kubernetes.io/tolerate-unready-endpoints"
+)
+
+// externalTrafficAnnotationValues maps external_traffic_policy
+// to the values of the external traffic annotation
+var externalTrafficAnnotationValues = map[string]string{
+	"Local":   "OnlyLocal",
+	"Cluster": "Global",
+}
+
 // Flatteners
 
-func flattenIntOrString(in intstr.IntOrString) int {
-	return in.IntValue()
+func flattenIntOrString(in intstr.IntOrString) string {
+	return in.String()
 }
 
 func flattenServicePort(in []v1.ServicePort) []interface{} {
@@ -27,7 +46,7 @@ func flattenServicePort(in []v1.ServicePort) []interface{} {
 	return att
 }
 
-func flattenServiceSpec(in v1.ServiceSpec) []interface{} {
+func flattenServiceSpec(in v1.ServiceSpec, annotations map[string]string) []interface{} {
 	att := make(map[string]interface{})
 	if len(in.Ports) > 0 {
 		att["port"] = flattenServicePort(in.Ports)
@@ -56,6 +75,19 @@ func flattenServiceSpec(in v1.ServiceSpec) []interface{} {
 	if in.ExternalName != "" {
 		att["external_name"] = in.ExternalName
 	}
+	if v, ok := annotations[serviceExternalTrafficAnnotation]; ok {
+		for policy, value := range externalTrafficAnnotationValues {
+			if v == value {
+				att["external_traffic_policy"] = policy
+			}
+		}
+	}
+	if v, err := strconv.Atoi(annotations[serviceHealthCheckNodePortAnnotation]); err == nil {
+		att["health_check_node_port"] = v
+	}
+	if v, err := strconv.ParseBool(annotations[serviceTolerateUnreadyEndpointsAnnotation]); err == nil {
+		att["publish_not_ready_addresses"] = v
+	}
 	return []interface{}{att}
 }
 
@@ -74,10 +106,6 @@ func flattenLoadBalancerIngress(in []v1.LoadBalancerIngress) []interface{} {
 
 // Expanders
 
-func expandIntOrString(in int) intstr.IntOrString {
-	return intstr.FromInt(in)
-}
-
 func expandServicePort(l []interface{}) []v1.ServicePort {
 	if len(l) == 0 || l[0] == nil {
 		return []v1.ServicePort{}
@@ -86,8 +114,10 @@ func expandServicePort(l []interface{}) []v1.ServicePort {
 	for i, n := range l {
 		cfg := n.(map[string]interface{})
 		obj[i] = v1.ServicePort{
-			Port:       int32(cfg["port"].(int)),
-			TargetPort: expandIntOrString(cfg["target_port"].(int)),
+			Port: int32(cfg["port"].(int)),
+		}
+		if v, ok := cfg["target_port"].(string); ok && v != "" {
+			obj[i].TargetPort = expandPort(v)
 		}
 		if v, ok := cfg["name"].(string); ok {
 			obj[i].Name = v
@@ -139,46 +169,46 @@ func expandServiceSpec(l []interface{}) v1.ServiceSpec {
 	return obj
 }
 
-// Patch Ops
-
-func patchServiceSpec(keyPrefix, pathPrefix string, d *schema.ResourceData) PatchOperations {
-	ops := make([]PatchOperation, 0, 0)
-	if d.HasChange(keyPrefix + "selector") {
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "selector",
-			Value: d.Get(keyPrefix + "selector").(map[string]interface{}),
-		})
+// expandServiceSpecAnnotations returns the annotations carrying
+// the spec fields which have no counterpart in the vendored ServiceSpec
+func expandServiceSpecAnnotations(l []interface{}) map[string]string {
+	obj := make(map[string]string)
+	if len(l) == 0 || l[0] == nil {
+		return obj
 	}
-	if d.HasChange(keyPrefix + "type") {
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "type",
-			Value: d.Get(keyPrefix + "type").(string),
-		})
+	in := l[0].(map[string]interface{})
+
+	policy, _ := in["external_traffic_policy"].(string)
+	if policy != "" {
+		obj[serviceExternalTrafficAnnotation] = externalTrafficAnnotationValues[policy]
 	}
-	if d.HasChange(keyPrefix + "session_affinity") {
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "sessionAffinity",
-			Value: d.Get(keyPrefix + "session_affinity").(string),
-		})
+	// The port allocated for the Local policy stays in state as it's
+	// computed, but must not be sent along once the policy is changed
+	if v, ok := in["health_check_node_port"].(int); ok && v != 0 && policy == "Local" {
+		obj[serviceHealthCheckNodePortAnnotation] = strconv.Itoa(v)
 	}
-	if d.HasChange(keyPrefix + "load_balancer_ip") {
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "loadBalancerIP",
-			Value: d.Get(keyPrefix + "load_balancer_ip").(string),
-		})
+	if v, ok := in["publish_not_ready_addresses"].(bool); ok && v {
+		obj[serviceTolerateUnreadyEndpointsAnnotation] = "true"
 	}
+	return obj
+}
+
+// Patch Ops
+
+func patchServiceSpec(keyPrefix, pathPrefix string, d resourceChanges) (PatchOperations, error) {
+	ops := make([]PatchOperation, 0, 0)
+	o, n := d.GetChange(strings.TrimSuffix(keyPrefix, ".0."))
+	oldSpec := expandServiceSpec(o.([]interface{}))
+	newSpec := expandServiceSpec(n.([]interface{}))
+
+	// Sets have no stable order to address their items by,
+	// so they are replaced as a whole
 	if d.HasChange(keyPrefix + "load_balancer_source_ranges") {
 		ops = append(ops, &ReplaceOperation{
 			Path:  pathPrefix + "loadBalancerSourceRanges",
 			Value: d.Get(keyPrefix + "load_balancer_source_ranges").(*schema.Set).List(),
 		})
 	}
-	if d.HasChange(keyPrefix + "port") {
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "ports",
-			Value: expandServicePort(d.Get(keyPrefix + "port").([]interface{})),
-		})
-	}
 	if d.HasChange(keyPrefix + "external_ips") {
 		// If we haven't done this the deprecated field would have priority
 		ops = append(ops, &ReplaceOperation{
@@ -191,11 +221,22 @@ func patchServiceSpec(keyPrefix, pathPrefix string, d *schema.ResourceData) Patc
 			Value: d.Get(keyPrefix + "external_ips").(*schema.Set).List(),
 		})
 	}
-	if d.HasChange(keyPrefix + "external_name") {
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "externalName",
-			Value: d.Get(keyPrefix + "external_name").(string),
-		})
+	oldSpec.LoadBalancerSourceRanges, newSpec.LoadBalancerSourceRanges = nil, nil
+	oldSpec.ExternalIPs, newSpec.ExternalIPs = nil, nil
+
+	diffOps, err := diffObjects(pathPrefix, oldSpec, newSpec)
+	if err != nil {
+		return ops, err
+	}
+	ops = append(ops, diffOps...)
+
+	if d.HasChange(keyPrefix+"external_traffic_policy") ||
+		d.HasChange(keyPrefix+"health_check_node_port") ||
+		d.HasChange(keyPrefix+"publish_not_ready_addresses") {
+		diffOps := diffStringMap("/metadata/annotations",
+			flattenStringMap(expandServiceSpecAnnotations(o.([]interface{}))),
+			flattenStringMap(expandServiceSpecAnnotations(n.([]interface{}))))
+		ops = append(ops, diffOps...)
 	}
-	return ops
+	return ops, nil
 }
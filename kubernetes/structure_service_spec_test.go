@@ -0,0 +1,322 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+func TestExpandFlattenServiceSpec(t *testing.T) {
+	cases := []struct {
+		Input               map[string]interface{}
+		ExpectedSpec        v1.ServiceSpec
+		ExpectedAnnotations map[string]string
+	}{
+		{
+			Input: map[string]interface{}{
+				"cluster_ip": "10.0.0.10",
+				"port": []interface{}{
+					map[string]interface{}{
+						"name":        "http",
+						"node_port":   0,
+						"port":        80,
+						"protocol":    "TCP",
+						"target_port": "8080",
+					},
+					map[string]interface{}{
+						"name":        "metrics",
+						"node_port":   0,
+						"port":        9090,
+						"protocol":    "TCP",
+						"target_port": "metrics",
+					},
+				},
+				"session_affinity": "None",
+				"type":             "ClusterIP",
+			},
+			ExpectedSpec: v1.ServiceSpec{
+				ClusterIP: "10.0.0.10",
+				Ports: []v1.ServicePort{
+					{
+						Name:       "http",
+						Port:       80,
+						Protocol:   v1.ProtocolTCP,
+						TargetPort: intstr.FromInt(8080),
+					},
+					{
+						Name:       "metrics",
+						Port:       9090,
+						Protocol:   v1.ProtocolTCP,
+						TargetPort: intstr.FromString("metrics"),
+					},
+				},
+				SessionAffinity: v1.ServiceAffinityNone,
+				Type:            v1.ServiceTypeClusterIP,
+			},
+			ExpectedAnnotations: map[string]string{},
+		},
+		{
+			Input: map[string]interface{}{
+				"cluster_ip":              "10.0.0.11",
+				"external_traffic_policy": "Local",
+				"health_check_node_port":  30123,
+				"port": []interface{}{
+					map[string]interface{}{
+						"name":        "",
+						"node_port":   30080,
+						"port":        80,
+						"protocol":    "TCP",
+						"target_port": "http",
+					},
+				},
+				"session_affinity": "None",
+				"type":             "LoadBalancer",
+			},
+			ExpectedSpec: v1.ServiceSpec{
+				ClusterIP: "10.0.0.11",
+				Ports: []v1.ServicePort{
+					{
+						NodePort:   30080,
+						Port:       80,
+						Protocol:   v1.ProtocolTCP,
+						TargetPort: intstr.FromString("http"),
+					},
+				},
+				SessionAffinity: v1.ServiceAffinityNone,
+				Type:            v1.ServiceTypeLoadBalancer,
+			},
+			ExpectedAnnotations: map[string]string{
+				"service.beta.kubernetes.io/external-traffic":     "OnlyLocal",
+				"service.beta.kubernetes.io/healthcheck-nodeport": "30123",
+			},
+		},
+		{
+			Input: map[string]interface{}{
+				"cluster_ip":                  "None",
+				"publish_not_ready_addresses": true,
+				"session_affinity":            "None",
+				"type":                        "ClusterIP",
+			},
+			ExpectedSpec: v1.ServiceSpec{
+				ClusterIP:       "None",
+				SessionAffinity: v1.ServiceAffinityNone,
+				Type:            v1.ServiceTypeClusterIP,
+			},
+			ExpectedAnnotations: map[string]string{
+				"service.alpha.kubernetes.io/tolerate-unready-endpoints": "true",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		in := []interface{}{tc.Input}
+		spec := expandServiceSpec(in)
+		if !reflect.DeepEqual(spec, tc.ExpectedSpec) {
+			t.Fatalf("%d: unexpected spec.\nExpected: %#v\nGiven:    %#v", i, tc.ExpectedSpec, spec)
+		}
+		annotations := expandServiceSpecAnnotations(in)
+		if !reflect.DeepEqual(annotations, tc.ExpectedAnnotations) {
+			t.Fatalf("%d: unexpected annotations.\nExpected: %#v\nGiven:    %#v", i, tc.ExpectedAnnotations, annotations)
+		}
+
+		out := flattenServiceSpec(spec, annotations)
+		if !reflect.DeepEqual(out, in) {
+			t.Fatalf("%d: flattened spec doesn't match input.\nExpected: %#v\nGiven:    %#v", i, in, out)
+		}
+	}
+}
+
+func TestPatchServiceSpec(t *testing.T) {
+	port := func(targetPort string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{"port": 80, "target_port": targetPort},
+		}
+	}
+
+	testCases := []struct {
+		Name        string
+		Old         map[string]interface{}
+		New         map[string]interface{}
+		ExpectedOps PatchOperations
+	}{
+		{
+			Name: "target_port changed to a named port",
+			Old:  map[string]interface{}{"port": port("8080")},
+			New:  map[string]interface{}{"port": port("http")},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path:  "/spec/ports/0/targetPort",
+					Value: "http",
+				},
+			},
+		},
+		{
+			Name: "selector extended",
+			Old: map[string]interface{}{
+				"port":     port("8080"),
+				"selector": map[string]interface{}{"app": "web"},
+			},
+			New: map[string]interface{}{
+				"port":     port("8080"),
+				"selector": map[string]interface{}{"app": "web", "tier": "frontend"},
+			},
+			ExpectedOps: []PatchOperation{
+				&AddOperation{
+					Path:  "/spec/selector/tier",
+					Value: "frontend",
+				},
+			},
+		},
+		{
+			Name: "external_ips set",
+			Old:  map[string]interface{}{"port": port("8080")},
+			New: map[string]interface{}{
+				"external_ips": []interface{}{"10.0.0.1"},
+				"port":         port("8080"),
+			},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path:  "/spec/deprecatedPublicIPs",
+					Value: nil,
+				},
+				&ReplaceOperation{
+					Path:  "/spec/externalIPs",
+					Value: []interface{}{"10.0.0.1"},
+				},
+			},
+		},
+		{
+			Name: "publish_not_ready_addresses enabled",
+			Old: map[string]interface{}{
+				"cluster_ip": "None",
+				"port":       port("8080"),
+			},
+			New: map[string]interface{}{
+				"cluster_ip":                  "None",
+				"port":                        port("8080"),
+				"publish_not_ready_addresses": true,
+			},
+			ExpectedOps: []PatchOperation{
+				&AddOperation{
+					Path:  "/metadata/annotations/service.alpha.kubernetes.io~1tolerate-unready-endpoints",
+					Value: "true",
+				},
+			},
+		},
+		{
+			Name: "external_traffic_policy changed",
+			Old: map[string]interface{}{
+				"external_traffic_policy": "Local",
+				"port":                    port("8080"),
+				"type":                    "LoadBalancer",
+			},
+			New: map[string]interface{}{
+				"external_traffic_policy": "Cluster",
+				"port":                    port("8080"),
+				"type":                    "LoadBalancer",
+			},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path:  "/metadata/annotations/service.beta.kubernetes.io~1external-traffic",
+					Value: "Global",
+				},
+			},
+		},
+		{
+			Name: "external_traffic_policy changed to Cluster",
+			Old: map[string]interface{}{
+				"external_traffic_policy": "Local",
+				"health_check_node_port":  30123,
+				"port":                    port("8080"),
+				"type":                    "LoadBalancer",
+			},
+			New: map[string]interface{}{
+				"external_traffic_policy": "Cluster",
+				"port":                    port("8080"),
+				"type":                    "LoadBalancer",
+			},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path:  "/metadata/annotations/service.beta.kubernetes.io~1external-traffic",
+					Value: "Global",
+				},
+				&RemoveOperation{
+					Path: "/metadata/annotations/service.beta.kubernetes.io~1healthcheck-nodeport",
+				},
+			},
+		},
+		{
+			Name: "health_check_node_port set",
+			Old: map[string]interface{}{
+				"external_traffic_policy": "Local",
+				"port":                    port("8080"),
+				"type":                    "LoadBalancer",
+			},
+			New: map[string]interface{}{
+				"external_traffic_policy": "Local",
+				"health_check_node_port":  30123,
+				"port":                    port("8080"),
+				"type":                    "LoadBalancer",
+			},
+			ExpectedOps: []PatchOperation{
+				&AddOperation{
+					Path:  "/metadata/annotations/service.beta.kubernetes.io~1healthcheck-nodeport",
+					Value: "30123",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		ops := testServiceSpecUpdate(t, tc.Old, tc.New)
+		if !ops.Equal(tc.ExpectedOps) {
+			t.Fatalf("%s: operations don't match.\nExpected: %v\nGiven:    %v\n", tc.Name, tc.ExpectedOps, ops)
+		}
+	}
+}
+
+// testServiceSpecUpdate returns the operations patchServiceSpec
+// emits when a service is updated from oldSpec to newSpec
+func testServiceSpecUpdate(t *testing.T, oldSpec, newSpec map[string]interface{}) PatchOperations {
+	raw := func(spec map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"metadata": []interface{}{
+				map[string]interface{}{"name": "test"},
+			},
+			"spec": []interface{}{spec},
+		}
+	}
+
+	r := resourceKubernetesService()
+	old := schema.TestResourceDataRaw(t, r.Schema, raw(oldSpec))
+	old.SetId("default/test")
+	state := old.State()
+
+	c, err := config.NewRawConfig(raw(newSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := r.Diff(state, terraform.NewResourceConfig(c))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.RequiresNew() {
+		t.Fatal("Expected an in-place update, got a replacement")
+	}
+
+	var ops PatchOperations
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		var err error
+		ops, err = patchServiceSpec("spec.0.", "/spec/", d)
+		return err
+	}
+	if _, err := r.Apply(state, diff, nil); err != nil {
+		t.Fatal(err)
+	}
+	return ops
+}
@@ -0,0 +1,112 @@
+package kubernetes
+
+import (
+	"k8s.io/kubernetes/pkg/api/v1"
+	apps_v1beta1 "k8s.io/kubernetes/pkg/apis/apps/v1beta1"
+)
+
+// Expanders
+
+func expandStatefulSetSpec(l []interface{}) (apps_v1beta1.StatefulSetSpec, error) {
+	obj := apps_v1beta1.StatefulSetSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.Replicas = ptrToInt32(int32(in["replicas"].(int)))
+	if v, ok := in["selector"].([]interface{}); ok && len(v) > 0 {
+		obj.Selector = expandLabelSelector(v)
+	}
+	obj.ServiceName = in["service_name"].(string)
+
+	template, err := expandTemplateReferance(in["template"].([]interface{}))
+	if err != nil {
+		return obj, err
+	}
+	obj.Template = template
+
+	if v, ok := in["volume_claim_template"].([]interface{}); ok && len(v) > 0 {
+		claims, err := expandPersistentVolumeClaimTemplates(v)
+		if err != nil {
+			return obj, err
+		}
+		obj.VolumeClaimTemplates = claims
+	}
+
+	return obj, nil
+}
+
+func expandPersistentVolumeClaimTemplates(l []interface{}) ([]v1.PersistentVolumeClaim, error) {
+	claims := make([]v1.PersistentVolumeClaim, len(l), len(l))
+	for i, c := range l {
+		in := c.(map[string]interface{})
+		spec, err := expandPersistentVolumeClaimSpec(in["spec"].([]interface{}))
+		if err != nil {
+			return claims, err
+		}
+		claims[i] = v1.PersistentVolumeClaim{
+			ObjectMeta: expandMetadata(in["metadata"].([]interface{})),
+			Spec:       spec,
+		}
+	}
+	return claims, nil
+}
+
+// Flatteners
+
+func flattenStatefulSetSpec(in apps_v1beta1.StatefulSetSpec) ([]interface{}, error) {
+	att := make(map[string]interface{})
+
+	if in.Replicas != nil {
+		att["replicas"] = *in.Replicas
+	}
+	if in.Selector != nil {
+		att["selector"] = flattenLabelSelector(in.Selector)
+	}
+	att["service_name"] = in.ServiceName
+
+	template, err := flattenTemplateReferance(in.Template)
+	if err != nil {
+		return nil, err
+	}
+	att["template"] = template
+
+	if len(in.VolumeClaimTemplates) > 0 {
+		att["volume_claim_template"] = flattenPersistentVolumeClaimTemplates(in.VolumeClaimTemplates)
+	}
+
+	return []interface{}{att}, nil
+}
+
+func flattenPersistentVolumeClaimTemplates(in []v1.PersistentVolumeClaim) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, c := range in {
+		m := make(map[string]interface{})
+		m["metadata"] = flattenTemplateMetadata(c.ObjectMeta)
+		m["spec"] = flattenPersistentVolumeClaimSpec(c.Spec)
+		att[i] = m
+	}
+	return att
+}
+
+// Patchers
+
+func patchStatefulSetSpec(prefix string, pathPrefix string, d resourceChanges) ([]PatchOperation, error) {
+	ops := make([]PatchOperation, 0)
+
+	if d.HasChange(prefix + "replicas") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  pathPrefix + "/replicas",
+			Value: d.Get(prefix + "replicas").(int),
+		})
+	}
+	if d.HasChange(prefix + "template") {
+		diffOps, err := patchTemplateReferance(prefix+"template", pathPrefix+"/template", d)
+		if err != nil {
+			return ops, err
+		}
+		ops = append(ops, diffOps...)
+	}
+	return ops, nil
+}
@@ -0,0 +1,167 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+func TestExpandPersistentVolumeClaimTemplates(t *testing.T) {
+	cases := []struct {
+		Input    []interface{}
+		Expected []v1.PersistentVolumeClaim
+	}{
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"metadata": []interface{}{
+						map[string]interface{}{
+							"name":        "data",
+							"annotations": map[string]interface{}{},
+							"labels":      map[string]interface{}{"app": "db"},
+						},
+					},
+					"spec": []interface{}{
+						map[string]interface{}{
+							"access_modes": schema.NewSet(schema.HashString, []interface{}{"ReadWriteOnce"}),
+							"resources": []interface{}{
+								map[string]interface{}{
+									"requests": map[string]interface{}{"storage": "1Gi"},
+								},
+							},
+							"storage_class_name": "fast",
+						},
+					},
+				},
+			},
+			Expected: []v1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "data",
+						Annotations: map[string]string{},
+						Labels:      map[string]string{"app": "db"},
+					},
+					Spec: v1.PersistentVolumeClaimSpec{
+						AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{
+								v1.ResourceStorage: resource.MustParse("1Gi"),
+							},
+						},
+						StorageClassName: ptrToString("fast"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := expandPersistentVolumeClaimTemplates(tc.Input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(output, tc.Expected) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.Expected, output)
+		}
+	}
+}
+
+func TestFlattenPersistentVolumeClaimTemplates(t *testing.T) {
+	in := []v1.PersistentVolumeClaim{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "data",
+			},
+			Spec: v1.PersistentVolumeClaimSpec{
+				AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+				},
+			},
+		},
+	}
+
+	output := flattenPersistentVolumeClaimTemplates(in)
+	if len(output) != 1 {
+		t.Fatalf("Expected 1 claim template, given %d", len(output))
+	}
+	claim := output[0].(map[string]interface{})
+	metadata := claim["metadata"].([]map[string]interface{})
+	if metadata[0]["name"] != "data" {
+		t.Fatalf("Unexpected claim name: %#v", metadata[0]["name"])
+	}
+	spec := claim["spec"].([]interface{})[0].(map[string]interface{})
+	accessModes := spec["access_modes"].(*schema.Set)
+	if !accessModes.Contains("ReadWriteOnce") || accessModes.Len() != 1 {
+		t.Fatalf("Unexpected access modes: %#v", accessModes.List())
+	}
+	requests := spec["resources"].([]interface{})[0].(map[string]interface{})["requests"]
+	if !reflect.DeepEqual(requests, map[string]string{"storage": "1Gi"}) {
+		t.Fatalf("Unexpected resource requests: %#v", requests)
+	}
+}
+
+func TestStatefulSetRevisionsPodNeedsUpdate(t *testing.T) {
+	pod := func(revision string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"controller-revision-hash": revision},
+		}}
+	}
+	cases := []struct {
+		Raw      string
+		Ordinal  int32
+		Pod      *v1.Pod
+		Expected bool
+	}{
+		{
+			Raw:      `{"spec":{},"status":{"replicas":1}}`,
+			Pod:      pod(""),
+			Expected: false,
+		},
+		{
+			Raw:      `{"spec":{"updateStrategy":{"type":"OnDelete"}},"status":{"updateRevision":"web-2"}}`,
+			Pod:      pod("web-1"),
+			Expected: false,
+		},
+		{
+			Raw:      `{"spec":{"updateStrategy":{"type":"RollingUpdate"}},"status":{"updateRevision":"web-2"}}`,
+			Pod:      pod("web-1"),
+			Expected: true,
+		},
+		{
+			Raw:      `{"spec":{"updateStrategy":{"type":"RollingUpdate"}},"status":{"updateRevision":"web-2"}}`,
+			Pod:      pod("web-2"),
+			Expected: false,
+		},
+		{
+			Raw:      `{"spec":{"updateStrategy":{"type":"RollingUpdate","rollingUpdate":{"partition":2}}},"status":{"updateRevision":"web-2"}}`,
+			Ordinal:  1,
+			Pod:      pod("web-1"),
+			Expected: false,
+		},
+		{
+			Raw:      `{"spec":{"updateStrategy":{"type":"RollingUpdate","rollingUpdate":{"partition":2}}},"status":{"updateRevision":"web-2"}}`,
+			Ordinal:  2,
+			Pod:      pod("web-1"),
+			Expected: true,
+		},
+	}
+
+	for i, tc := range cases {
+		revisions := &statefulSetRevisions{}
+		if err := json.Unmarshal([]byte(tc.Raw), revisions); err != nil {
+			t.Fatal(err)
+		}
+		if out := revisions.podNeedsUpdate(tc.Pod, tc.Ordinal); out != tc.Expected {
+			t.Fatalf("Case %d: expected %t, got %t", i, tc.Expected, out)
+		}
+	}
+}
@@ -2,13 +2,19 @@ package kubernetes
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"log"
 	"net/url"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	api "k8s.io/kubernetes/pkg/api/v1"
 )
 
@@ -26,6 +32,28 @@ func buildId(meta metav1.ObjectMeta) string {
 	return meta.Namespace + "/" + meta.Name
 }
 
+// importNamespacedState imports namespaced objects, resolving
+// IDs given without a namespace against the provider's namespace
+func importNamespacedState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !strings.Contains(d.Id(), "/") {
+		d.SetId(buildId(metav1.ObjectMeta{
+			Namespace: meta.(*kubeProvider).namespace,
+			Name:      d.Id(),
+		}))
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandNamespacedMetadata expands the metadata of a namespaced object,
+// which is placed in the provider's namespace unless configured otherwise
+func expandNamespacedMetadata(d *schema.ResourceData, meta interface{}) metav1.ObjectMeta {
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	if metadata.Namespace == "" {
+		metadata.Namespace = meta.(*kubeProvider).namespace
+	}
+	return metadata
+}
+
 func expandMetadata(in []interface{}) metav1.ObjectMeta {
 	meta := metav1.ObjectMeta{}
 	if len(in) < 1 {
@@ -45,11 +73,60 @@ func expandMetadata(in []interface{}) metav1.ObjectMeta {
 	if v, ok := m["namespace"]; ok {
 		meta.Namespace = v.(string)
 	}
+	if v, ok := m["finalizers"].([]interface{}); ok && len(v) > 0 {
+		meta.Finalizers = expandStringSlice(v)
+	}
+	if v, ok := m["owner_reference"].([]interface{}); ok && len(v) > 0 {
+		meta.OwnerReferences = expandOwnerReferences(v)
+	}
 
 	return meta
 }
 
-func patchMetadata(keyPrefix, pathPrefix string, d *schema.ResourceData) PatchOperations {
+func expandOwnerReferences(l []interface{}) []metav1.OwnerReference {
+	obj := make([]metav1.OwnerReference, len(l), len(l))
+	for i, n := range l {
+		ref := n.(map[string]interface{})
+		obj[i] = metav1.OwnerReference{
+			APIVersion: ref["api_version"].(string),
+			Kind:       ref["kind"].(string),
+			Name:       ref["name"].(string),
+			UID:        types.UID(ref["uid"].(string)),
+		}
+		if v, ok := ref["controller"].(bool); ok && v {
+			obj[i].Controller = ptrToBool(v)
+		}
+		if v, ok := ref["block_owner_deletion"].(bool); ok && v {
+			obj[i].BlockOwnerDeletion = ptrToBool(v)
+		}
+	}
+	return obj
+}
+
+func flattenOwnerReferences(in []metav1.OwnerReference) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, ref := range in {
+		m := map[string]interface{}{
+			"api_version": ref.APIVersion,
+			"kind":        ref.Kind,
+			"name":        ref.Name,
+			"uid":         string(ref.UID),
+		}
+		if ref.Controller != nil {
+			m["controller"] = *ref.Controller
+		}
+		if ref.BlockOwnerDeletion != nil {
+			m["block_owner_deletion"] = *ref.BlockOwnerDeletion
+		}
+		att[i] = m
+	}
+	return att
+}
+
+// patchMetadata returns the operations updating annotations and labels.
+// Finalizers and owner references are patched by patchResource, as the
+// lists stored by the API server may hold items Terraform doesn't manage.
+func patchMetadata(keyPrefix, pathPrefix string, d resourceChanges) PatchOperations {
 	ops := make([]PatchOperation, 0, 0)
 	if d.HasChange(keyPrefix + "annotations") {
 		oldV, newV := d.GetChange(keyPrefix + "annotations")
@@ -64,6 +141,230 @@ func patchMetadata(keyPrefix, pathPrefix string, d *schema.ResourceData) PatchOp
 	return ops
 }
 
+// metadataListsChanged reports whether the managed finalizers or owner
+// references of the object were changed
+func metadataListsChanged(d *schema.ResourceData) bool {
+	return d.HasChange("metadata.0.finalizers") || d.HasChange("metadata.0.owner_reference")
+}
+
+// patchMetadataLists returns the operations turning the finalizers and
+// owner references of live, the metadata as stored by the API server,
+// into those in the configuration. Items which were never in the
+// configuration, such as those added by controllers, are left alone.
+func patchMetadataLists(pathPrefix string, d *schema.ResourceData, live metav1.ObjectMeta) PatchOperations {
+	ops := make([]PatchOperation, 0, 0)
+	if d.HasChange("metadata.0.finalizers") {
+		oldV, newV := d.GetChange("metadata.0.finalizers")
+		liveV := make([]interface{}, len(live.Finalizers), len(live.Finalizers))
+		for i, f := range live.Finalizers {
+			liveV[i] = f
+		}
+		diffOps := diffManagedListItems(pathPrefix+"finalizers", liveV, oldV.([]interface{}), newV.([]interface{}),
+			func(v interface{}) interface{} { return v })
+		ops = append(ops, diffOps...)
+	}
+	if d.HasChange("metadata.0.owner_reference") {
+		oldV, newV := d.GetChange("metadata.0.owner_reference")
+		// Flattening and expanding again drops the unset flags the API
+		// server may report as false, which the configuration can't hold
+		liveV := ownerReferencesToList(expandOwnerReferences(flattenOwnerReferences(live.OwnerReferences)))
+		diffOps := diffManagedListItems(pathPrefix+"ownerReferences", liveV,
+			ownerReferencesToList(expandOwnerReferences(oldV.([]interface{}))),
+			ownerReferencesToList(expandOwnerReferences(newV.([]interface{}))),
+			func(v interface{}) interface{} { return v.(metav1.OwnerReference).UID })
+		ops = append(ops, diffOps...)
+	}
+	return ops
+}
+
+func ownerReferencesToList(refs []metav1.OwnerReference) []interface{} {
+	l := make([]interface{}, len(refs), len(refs))
+	for i, ref := range refs {
+		l[i] = ref
+	}
+	return l
+}
+
+// readLiveMetadata reads the metadata of the object as stored by the API
+// server, including the finalizers and owner references left out of state
+func readLiveMetadata(d *schema.ResourceData, meta interface{}) (metav1.ObjectMeta, error) {
+	var obj struct {
+		Metadata metav1.ObjectMeta `json:"metadata"`
+	}
+	selfLink := d.Get("metadata.0.self_link").(string)
+	if selfLink == "" {
+		return obj.Metadata, fmt.Errorf("%s: no self link to read the object from", d.Id())
+	}
+	conn := meta.(*kubeProvider).conn
+	raw, err := conn.CoreV1().RESTClient().Get().AbsPath(selfLink).Do().Raw()
+	if err != nil {
+		return obj.Metadata, err
+	}
+	err = json.Unmarshal(raw, &obj)
+	return obj.Metadata, err
+}
+
+// maxConflictRetries bounds how many times an update is retried after
+// the object was found to have changed since it was last read
+const maxConflictRetries = 5
+
+// conflictRetryBackoff is how long to wait before the first retry of an
+// update, doubling with each further retry
+var conflictRetryBackoff = 500 * time.Millisecond
+
+// resourceChanges is what update operations are computed from, the
+// changes to a resource between its recorded and planned state
+type resourceChanges interface {
+	Get(key string) interface{}
+	GetChange(key string) (interface{}, interface{})
+	HasChange(key string) bool
+}
+
+// rebasedChanges are the planned changes of a resource which was re-read,
+// turning the object as last read into the planned state. Only fields
+// which the plan changes are considered, so fields changed by others
+// since aren't reverted.
+type rebasedChanges struct {
+	d *schema.ResourceData
+}
+
+func (c *rebasedChanges) Get(key string) interface{} {
+	_, n := c.d.GetChange(key)
+	return n
+}
+
+func (c *rebasedChanges) GetChange(key string) (interface{}, interface{}) {
+	o, n := c.d.GetChange(key)
+	if !c.d.HasChange(key) {
+		return o, n
+	}
+	// Values set by the read are only visible through Get
+	return c.d.Get(key), n
+}
+
+func (c *rebasedChanges) HasChange(key string) bool {
+	o, n := c.GetChange(key)
+	if eq, ok := o.(schema.Equal); ok {
+		return !eq.Equal(n)
+	}
+	return !reflect.DeepEqual(o, n)
+}
+
+// patchResource submits the operations returned by buildOps via patch,
+// guarded by a test of the resource version recorded in state, so that
+// changes made to the object since it was last read are never silently
+// overwritten. On conflict the object is re-read and, depending on the
+// provider's retry_on_conflict setting, the update is either failed or
+// retried with operations rebuilt against the object as re-read, so that
+// list items are addressed by their current indexes.
+func patchResource(d *schema.ResourceData, meta interface{}, buildOps func(d resourceChanges) (PatchOperations, error), read schema.ReadFunc, patch func(data []byte) error) error {
+	var changes resourceChanges = d
+	return retryOnConflict(d, meta, read, func() error {
+		ops, err := buildOps(changes)
+		if err != nil {
+			return err
+		}
+		// Any further attempt follows a re-read of the object
+		changes = &rebasedChanges{d}
+
+		if metadataListsChanged(d) {
+			live, err := readLiveMetadata(d, meta)
+			if err != nil {
+				return fmt.Errorf("Failed to read metadata of %s: %s", d.Id(), err)
+			}
+			ops = append(ops, patchMetadataLists("/metadata/", d, live)...)
+		}
+		data, err := guardResourceVersion(d, ops).MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("Failed to marshal update operations: %s", err)
+		}
+		return patch(data)
+	})
+}
+
+// strategicPatchResource is the strategic merge patch counterpart of
+// patchResource. It submits a patch turning oldObj into newObj, carrying
+// the resource version recorded in state for the API server to check.
+// Lists are merged by their keys rather than indexes, so the patch can be
+// retried against a newer version as it is.
+func strategicPatchResource(d *schema.ResourceData, meta interface{}, oldObj, newObj interface{}, read schema.ReadFunc, patch func(data []byte) error) error {
+	p, err := diffStrategicMerge(oldObj, newObj)
+	if err != nil {
+		return fmt.Errorf("Failed to create strategic merge patch: %s", err)
+	}
+	return retryOnConflict(d, meta, read, func() error {
+		if version := d.Get("metadata.0.resource_version").(string); version != "" {
+			metadata, ok := p["metadata"].(map[string]interface{})
+			if !ok {
+				metadata = make(map[string]interface{})
+				p["metadata"] = metadata
+			}
+			metadata["resourceVersion"] = version
+		}
+		data, err := json.Marshal(p)
+		if err != nil {
+			return fmt.Errorf("Failed to marshal strategic merge patch: %s", err)
+		}
+		return patch(data)
+	})
+}
+
+// retryOnConflict calls update until it succeeds or fails for a reason other
+// than the object having changed since it was last read, re-reading the
+// object before each retry. Unless the provider retries on conflict, the
+// first conflict fails the update.
+func retryOnConflict(d *schema.ResourceData, meta interface{}, read schema.ReadFunc, update func() error) error {
+	retry := meta.(*kubeProvider).retryOnConflict
+	backoff := conflictRetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := update()
+		if err == nil || !isResourceVersionConflict(err) {
+			return err
+		}
+
+		if rerr := read(d, meta); rerr != nil {
+			return rerr
+		}
+		log.Printf("[DEBUG] %s changed since last refresh: %s", d.Id(), err)
+		if !retry || attempt >= maxConflictRetries {
+			return fmt.Errorf("%s: object changed since last refresh, refresh and try again: %s", d.Id(), err)
+		}
+		log.Printf("[INFO] Retrying update of %s against resource version %q in %s",
+			d.Id(), d.Get("metadata.0.resource_version").(string), backoff)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// guardResourceVersion prepends a test of the resource version recorded
+// in state to ops
+func guardResourceVersion(d *schema.ResourceData, ops PatchOperations) PatchOperations {
+	version := d.Get("metadata.0.resource_version").(string)
+	if version == "" {
+		return ops
+	}
+	guarded := make([]PatchOperation, 0, len(ops)+1)
+	guarded = append(guarded, &TestOperation{
+		Path:  "/metadata/resourceVersion",
+		Value: version,
+	})
+	return append(guarded, ops...)
+}
+
+// isResourceVersionConflict reports whether err means the object was
+// modified by someone else since its resource version was recorded. The
+// API server reports a failed test operation like any other patch it
+// can't apply, so it's told apart by the path of the failed test.
+func isResourceVersionConflict(err error) bool {
+	if errors.IsConflict(err) {
+		return true
+	}
+	if !errors.IsInvalid(err) && !errors.IsInternalError(err) {
+		return false
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "testing value /metadata/resourceversion failed")
+}
+
 func expandStringMap(m map[string]interface{}) map[string]string {
 	result := make(map[string]string)
 	for k, v := range m {
@@ -72,6 +373,14 @@ func expandStringMap(m map[string]interface{}) map[string]string {
 	return result
 }
 
+func flattenStringMap(m map[string]string) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
 func expandStringSlice(s []interface{}) []string {
 	result := make([]string, len(s), len(s))
 	for k, v := range s {
@@ -80,7 +389,19 @@ func expandStringSlice(s []interface{}) []string {
 	return result
 }
 
-func flattenMetadata(meta metav1.ObjectMeta) []map[string]interface{} {
+// flattenMetadata flattens the metadata of an object. Only the finalizers
+// and owner references in the configuration are kept, so those added by
+// the cluster or controllers don't show up as changes.
+func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData) []map[string]interface{} {
+	m := flattenTemplateMetadata(meta)
+	m[0]["finalizers"] = managedFinalizers(meta.Finalizers, d)
+	m[0]["owner_reference"] = flattenOwnerReferences(managedOwnerReferences(meta.OwnerReferences, d))
+	return m
+}
+
+// flattenTemplateMetadata flattens the metadata of an object template,
+// which has no finalizers or owner references of its own
+func flattenTemplateMetadata(meta metav1.ObjectMeta) []map[string]interface{} {
 	m := make(map[string]interface{})
 	m["annotations"] = removeInternalKeys(meta.Annotations)
 	if meta.GenerateName != "" {
@@ -100,6 +421,39 @@ func flattenMetadata(meta metav1.ObjectMeta) []map[string]interface{} {
 	return []map[string]interface{}{m}
 }
 
+// managedFinalizers returns the finalizers in the configuration which are
+// set on the object, in the order they are configured
+func managedFinalizers(finalizers []string, d *schema.ResourceData) []string {
+	configured := d.Get("metadata.0.finalizers").([]interface{})
+	managed := make([]string, 0, len(configured))
+	for _, c := range configured {
+		for _, f := range finalizers {
+			if f == c.(string) {
+				managed = append(managed, f)
+				break
+			}
+		}
+	}
+	return managed
+}
+
+// managedOwnerReferences returns the owner references in the configuration
+// which are set on the object, matched by the UID of the owner
+func managedOwnerReferences(refs []metav1.OwnerReference, d *schema.ResourceData) []metav1.OwnerReference {
+	configured := d.Get("metadata.0.owner_reference").([]interface{})
+	managed := make([]metav1.OwnerReference, 0, len(configured))
+	for _, c := range configured {
+		uid := c.(map[string]interface{})["uid"].(string)
+		for _, ref := range refs {
+			if string(ref.UID) == uid {
+				managed = append(managed, ref)
+				break
+			}
+		}
+	}
+	return managed
+}
+
 func removeInternalKeys(m map[string]string) map[string]string {
 	for k, _ := range m {
 		if isInternalKey(k) {
@@ -408,18 +762,6 @@ func schemaSetToInt64Array(set *schema.Set) []int64 {
 	}
 	return array
 }
-func flattenLabelSelectorRequirementList(l []metav1.LabelSelectorRequirement) []interface{} {
-	att := make([]map[string]interface{}, len(l))
-	for i, v := range l {
-		m := map[string]interface{}{}
-		m["key"] = v.Key
-		m["values"] = newStringSet(schema.HashString, v.Values)
-		m["operator"] = string(v.Operator)
-		att[i] = m
-	}
-	return []interface{}{att}
-}
-
 func flattenLocalObjectReferenceArray(in []api.LocalObjectReference) []interface{} {
 	att := make([]interface{}, len(in))
 	for i, v := range in {
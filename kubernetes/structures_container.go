@@ -276,6 +276,34 @@ func flattenContainerEnvs(in []v1.EnvVar) []interface{} {
 	return att
 }
 
+func flattenContainerEnvFroms(in []v1.EnvFromSource) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		m := map[string]interface{}{}
+		if v.ConfigMapRef != nil {
+			m["config_map_ref"] = flattenEnvFromSourceRef(v.ConfigMapRef.LocalObjectReference, v.ConfigMapRef.Optional)
+		}
+		if v.Prefix != "" {
+			m["prefix"] = v.Prefix
+		}
+		if v.SecretRef != nil {
+			m["secret_ref"] = flattenEnvFromSourceRef(v.SecretRef.LocalObjectReference, v.SecretRef.Optional)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenEnvFromSourceRef(ref v1.LocalObjectReference, optional *bool) []interface{} {
+	m := map[string]interface{}{
+		"name": ref.Name,
+	}
+	if optional != nil {
+		m["optional"] = *optional
+	}
+	return []interface{}{m}
+}
+
 func flattenContainerPorts(in []v1.ContainerPort) []interface{} {
 	att := make([]interface{}, len(in))
 	for i, v := range in {
@@ -351,6 +379,9 @@ func flattenContainers(in []v1.Container) ([]interface{}, error) {
 		if len(v.Env) > 0 {
 			c["env"] = flattenContainerEnvs(v.Env)
 		}
+		if len(v.EnvFrom) > 0 {
+			c["env_from"] = flattenContainerEnvFroms(v.EnvFrom)
+		}
 
 		if len(v.VolumeMounts) > 0 {
 			volumeMounts, err := flattenContainerVolumeMounts(v.VolumeMounts)
@@ -408,6 +439,9 @@ func expandContainers(ctrs []interface{}) ([]v1.Container, error) {
 				return cs, err
 			}
 		}
+		if v, ok := ctr["env_from"].([]interface{}); ok && len(v) > 0 {
+			cs[i].EnvFrom = expandContainerEnvFrom(v)
+		}
 
 		if policy, ok := ctr["image_pull_policy"]; ok {
 			cs[i].ImagePullPolicy = v1.PullPolicy(policy.(string))
@@ -682,6 +716,50 @@ func expandContainerEnv(in []interface{}) ([]v1.EnvVar, error) {
 	return envs, nil
 }
 
+func expandContainerEnvFrom(in []interface{}) []v1.EnvFromSource {
+	envFroms := make([]v1.EnvFromSource, len(in))
+	for i, c := range in {
+		if c == nil {
+			continue
+		}
+		p := c.(map[string]interface{})
+		if v, ok := p["config_map_ref"].([]interface{}); ok && len(v) > 0 {
+			ref, optional := expandEnvFromSourceRef(v)
+			envFroms[i].ConfigMapRef = &v1.ConfigMapEnvSource{
+				LocalObjectReference: ref,
+				Optional:             optional,
+			}
+		}
+		if v, ok := p["prefix"].(string); ok {
+			envFroms[i].Prefix = v
+		}
+		if v, ok := p["secret_ref"].([]interface{}); ok && len(v) > 0 {
+			ref, optional := expandEnvFromSourceRef(v)
+			envFroms[i].SecretRef = &v1.SecretEnvSource{
+				LocalObjectReference: ref,
+				Optional:             optional,
+			}
+		}
+	}
+	return envFroms
+}
+
+func expandEnvFromSourceRef(l []interface{}) (v1.LocalObjectReference, *bool) {
+	if l[0] == nil {
+		return v1.LocalObjectReference{}, nil
+	}
+	in := l[0].(map[string]interface{})
+	ref := v1.LocalObjectReference{}
+	if v, ok := in["name"].(string); ok {
+		ref.Name = v
+	}
+	var optional *bool
+	if v, ok := in["optional"].(bool); ok {
+		optional = ptrToBool(v)
+	}
+	return ref, optional
+}
+
 func expandContainerPort(in []interface{}) ([]v1.ContainerPort, error) {
 	if len(in) == 0 {
 		return []v1.ContainerPort{}, nil
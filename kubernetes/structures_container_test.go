@@ -0,0 +1,49 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+func TestExpandFlattenContainerEnvFrom(t *testing.T) {
+	envFrom := []v1.EnvFromSource{
+		{
+			Prefix: "DB_",
+			ConfigMapRef: &v1.ConfigMapEnvSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: "db-config"},
+				Optional:             ptrToBool(true),
+			},
+		},
+		{
+			SecretRef: &v1.SecretEnvSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: "db-credentials"},
+				Optional:             ptrToBool(false),
+			},
+		},
+	}
+
+	flattened := flattenContainerEnvFroms(envFrom)
+	expected := []interface{}{
+		map[string]interface{}{
+			"prefix": "DB_",
+			"config_map_ref": []interface{}{
+				map[string]interface{}{"name": "db-config", "optional": true},
+			},
+		},
+		map[string]interface{}{
+			"secret_ref": []interface{}{
+				map[string]interface{}{"name": "db-credentials", "optional": false},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, flattened) {
+		t.Fatalf("Expected:\n%#v\nGiven:\n%#v", expected, flattened)
+	}
+
+	expanded := expandContainerEnvFrom(flattened)
+	if !reflect.DeepEqual(envFrom, expanded) {
+		t.Fatalf("Expected:\n%#v\nGiven:\n%#v", envFrom, expanded)
+	}
+}
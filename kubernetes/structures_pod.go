@@ -1,9 +1,14 @@
 package kubernetes
 
 import (
+	"fmt"
+	"reflect"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kubernetes/pkg/api/v1"
 )
 
@@ -14,6 +19,9 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	if in.ActiveDeadlineSeconds != nil {
 		att["active_deadline_seconds"] = *in.ActiveDeadlineSeconds
 	}
+	if in.Affinity != nil {
+		att["affinity"] = flattenAffinity(in.Affinity)
+	}
 	containers, err := flattenContainers(in.Containers)
 	if err != nil {
 		return nil, err
@@ -31,6 +39,14 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	}
 	att["image_pull_secrets"] = flattenLocalObjectReferenceArray(in.ImagePullSecrets)
 
+	if len(in.InitContainers) > 0 {
+		initContainers, err := flattenContainers(in.InitContainers)
+		if err != nil {
+			return nil, err
+		}
+		att["init_container"] = initContainers
+	}
+
 	if in.NodeName != "" {
 		att["node_name"] = in.NodeName
 	}
@@ -55,6 +71,10 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 		att["termination_grace_period_seconds"] = *in.TerminationGracePeriodSeconds
 	}
 
+	if len(in.Tolerations) > 0 {
+		att["toleration"] = flattenTolerations(in.Tolerations)
+	}
+
 	if len(in.Volumes) > 0 {
 		v, err := flattenVolumes(in.Volumes)
 		if err != nil {
@@ -65,6 +85,77 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	return []interface{}{att}, nil
 }
 
+func flattenPodStatus(in v1.PodStatus) []interface{} {
+	att := make(map[string]interface{})
+	att["phase"] = string(in.Phase)
+	att["pod_ip"] = in.PodIP
+	att["host_ip"] = in.HostIP
+	att["start_time"] = flattenTime(in.StartTime)
+	att["qos_class"] = string(in.QOSClass)
+
+	conditions := make([]interface{}, len(in.Conditions))
+	for i, c := range in.Conditions {
+		conditions[i] = map[string]interface{}{
+			"type":    string(c.Type),
+			"status":  string(c.Status),
+			"reason":  c.Reason,
+			"message": c.Message,
+		}
+	}
+	att["conditions"] = conditions
+	att["container_status"] = flattenContainerStatuses(in.ContainerStatuses)
+
+	return []interface{}{att}
+}
+
+func flattenContainerStatuses(in []v1.ContainerStatus) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, cs := range in {
+		att[i] = map[string]interface{}{
+			"name":          cs.Name,
+			"image":         cs.Image,
+			"image_id":      cs.ImageID,
+			"ready":         cs.Ready,
+			"restart_count": int(cs.RestartCount),
+			"state":         flattenContainerState(cs.State),
+		}
+	}
+	return att
+}
+
+func flattenContainerState(in v1.ContainerState) []interface{} {
+	att := make(map[string]interface{})
+	if in.Waiting != nil {
+		att["waiting"] = []interface{}{map[string]interface{}{
+			"reason":  in.Waiting.Reason,
+			"message": in.Waiting.Message,
+		}}
+	}
+	if in.Running != nil {
+		att["running"] = []interface{}{map[string]interface{}{
+			"started_at": flattenTime(&in.Running.StartedAt),
+		}}
+	}
+	if in.Terminated != nil {
+		att["terminated"] = []interface{}{map[string]interface{}{
+			"exit_code":   int(in.Terminated.ExitCode),
+			"signal":      int(in.Terminated.Signal),
+			"reason":      in.Terminated.Reason,
+			"message":     in.Terminated.Message,
+			"started_at":  flattenTime(&in.Terminated.StartedAt),
+			"finished_at": flattenTime(&in.Terminated.FinishedAt),
+		}}
+	}
+	return []interface{}{att}
+}
+
+func flattenTime(in *metav1.Time) string {
+	if in == nil || in.IsZero() {
+		return ""
+	}
+	return in.UTC().Format(time.RFC3339)
+}
+
 func flattenPodSecurityContext(in *v1.PodSecurityContext) []interface{} {
 	att := make(map[string]interface{})
 	if in.FSGroup != nil {
@@ -111,6 +202,152 @@ func flattenSeLinuxOptions(in *v1.SELinuxOptions) []interface{} {
 	return []interface{}{att}
 }
 
+func flattenAffinity(in *v1.Affinity) []interface{} {
+	att := make(map[string]interface{})
+	if in.NodeAffinity != nil {
+		att["node_affinity"] = flattenNodeAffinity(in.NodeAffinity)
+	}
+	if in.PodAffinity != nil {
+		att["pod_affinity"] = flattenPodAffinityTerms(in.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, in.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+	if in.PodAntiAffinity != nil {
+		att["pod_anti_affinity"] = flattenPodAffinityTerms(in.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, in.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+	return []interface{}{att}
+}
+
+func flattenNodeAffinity(in *v1.NodeAffinity) []interface{} {
+	att := make(map[string]interface{})
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		terms := make([]interface{}, len(in.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms))
+		for i, t := range in.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+			terms[i] = flattenNodeSelectorTerm(t)
+		}
+		att["required_during_scheduling_ignored_during_execution"] = []interface{}{
+			map[string]interface{}{
+				"node_selector_term": terms,
+			},
+		}
+	}
+	if len(in.PreferredDuringSchedulingIgnoredDuringExecution) > 0 {
+		terms := make([]interface{}, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i, t := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms[i] = map[string]interface{}{
+				"weight":     int(t.Weight),
+				"preference": []interface{}{flattenNodeSelectorTerm(t.Preference)},
+			}
+		}
+		att["preferred_during_scheduling_ignored_during_execution"] = terms
+	}
+	return []interface{}{att}
+}
+
+func flattenNodeSelectorTerm(in v1.NodeSelectorTerm) map[string]interface{} {
+	exprs := make([]interface{}, len(in.MatchExpressions))
+	for i, e := range in.MatchExpressions {
+		m := map[string]interface{}{
+			"key":      e.Key,
+			"operator": string(e.Operator),
+		}
+		if len(e.Values) > 0 {
+			m["values"] = newStringSet(schema.HashString, e.Values)
+		}
+		exprs[i] = m
+	}
+	return map[string]interface{}{
+		"match_expressions": exprs,
+	}
+}
+
+func flattenPodAffinityTerms(required []v1.PodAffinityTerm, preferred []v1.WeightedPodAffinityTerm) []interface{} {
+	att := make(map[string]interface{})
+	if len(required) > 0 {
+		terms := make([]interface{}, len(required))
+		for i, t := range required {
+			terms[i] = flattenPodAffinityTerm(t)
+		}
+		att["required_during_scheduling_ignored_during_execution"] = terms
+	}
+	if len(preferred) > 0 {
+		terms := make([]interface{}, len(preferred))
+		for i, t := range preferred {
+			terms[i] = map[string]interface{}{
+				"weight":            int(t.Weight),
+				"pod_affinity_term": []interface{}{flattenPodAffinityTerm(t.PodAffinityTerm)},
+			}
+		}
+		att["preferred_during_scheduling_ignored_during_execution"] = terms
+	}
+	return []interface{}{att}
+}
+
+func flattenPodAffinityTerm(in v1.PodAffinityTerm) map[string]interface{} {
+	att := make(map[string]interface{})
+	if in.LabelSelector != nil {
+		att["label_selector"] = flattenLabelSelector(in.LabelSelector)
+	}
+	if len(in.Namespaces) > 0 {
+		att["namespaces"] = newStringSet(schema.HashString, in.Namespaces)
+	}
+	att["topology_key"] = in.TopologyKey
+	return att
+}
+
+func flattenTolerations(in []v1.Toleration) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, t := range in {
+		m := make(map[string]interface{})
+		if t.Effect != "" {
+			m["effect"] = string(t.Effect)
+		}
+		if t.Key != "" {
+			m["key"] = t.Key
+		}
+		if t.Operator != "" {
+			m["operator"] = string(t.Operator)
+		}
+		if t.TolerationSeconds != nil {
+			m["toleration_seconds"] = strconv.FormatInt(*t.TolerationSeconds, 10)
+		}
+		if t.Value != "" {
+			m["value"] = t.Value
+		}
+		att[i] = m
+	}
+	return att
+}
+
+// isDefaultToleration tells whether t is one of the tolerations of not ready
+// and unreachable nodes which the API server adds to every pod by default
+func isDefaultToleration(t v1.Toleration) bool {
+	return (t.Key == metav1.TaintNodeNotReady || t.Key == metav1.TaintNodeUnreachable) &&
+		t.Operator == v1.TolerationOpExists &&
+		t.Effect == v1.TaintEffectNoExecute &&
+		t.TolerationSeconds != nil
+}
+
+// removeDefaultTolerations drops the default tolerations added by the API
+// server from in, unless they're part of configured
+func removeDefaultTolerations(in, configured []v1.Toleration) []v1.Toleration {
+	out := make([]v1.Toleration, 0, len(in))
+	for _, t := range in {
+		if isDefaultToleration(t) && !containsToleration(configured, t) {
+			continue
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+func containsToleration(l []v1.Toleration, t v1.Toleration) bool {
+	for _, e := range l {
+		if reflect.DeepEqual(e, t) {
+			return true
+		}
+	}
+	return false
+}
+
 func flattenVolumes(volumes []v1.Volume) ([]interface{}, error) {
 	att := make([]interface{}, len(volumes))
 	for i, v := range volumes {
@@ -188,6 +425,9 @@ func flattenVolumes(volumes []v1.Volume) ([]interface{}, error) {
 		if v.PhotonPersistentDisk != nil {
 			obj["photon_persistent_disk"] = flattenPhotonPersistentDiskVolumeSource(v.PhotonPersistentDisk)
 		}
+		if v.Projected != nil {
+			obj["projected"] = flattenProjectedVolumeSource(v.Projected)
+		}
 		att[i] = obj
 	}
 	return att, nil
@@ -261,7 +501,9 @@ func flattenConfigMapVolumeSource(in *v1.ConfigMapVolumeSource) []interface{} {
 		for i, v := range in.Items {
 			m := map[string]interface{}{}
 			m["key"] = v.Key
-			m["mode"] = v.Mode
+			if v.Mode != nil {
+				m["mode"] = int(*v.Mode)
+			}
 			m["path"] = v.Path
 			items[i] = m
 		}
@@ -271,6 +513,66 @@ func flattenConfigMapVolumeSource(in *v1.ConfigMapVolumeSource) []interface{} {
 	return []interface{}{att}
 }
 
+func flattenProjectedVolumeSource(in *v1.ProjectedVolumeSource) []interface{} {
+	att := make(map[string]interface{})
+	if in.DefaultMode != nil {
+		att["default_mode"] = int(*in.DefaultMode)
+	}
+	sources := make([]interface{}, len(in.Sources))
+	for i, v := range in.Sources {
+		m := make(map[string]interface{})
+		if v.ConfigMap != nil {
+			cm := map[string]interface{}{
+				"name": v.ConfigMap.Name,
+			}
+			if len(v.ConfigMap.Items) > 0 {
+				cm["items"] = flattenKeyPath(v.ConfigMap.Items)
+			}
+			if v.ConfigMap.Optional != nil {
+				cm["optional"] = *v.ConfigMap.Optional
+			}
+			m["config_map"] = []interface{}{cm}
+		}
+		if v.DownwardAPI != nil {
+			dapi := make(map[string]interface{})
+			if len(v.DownwardAPI.Items) > 0 {
+				dapi["items"] = flattenDownwardAPIVolumeFile(v.DownwardAPI.Items)
+			}
+			m["downward_api"] = []interface{}{dapi}
+		}
+		if v.Secret != nil {
+			secret := map[string]interface{}{
+				"name": v.Secret.Name,
+			}
+			if len(v.Secret.Items) > 0 {
+				secret["items"] = flattenKeyPath(v.Secret.Items)
+			}
+			if v.Secret.Optional != nil {
+				secret["optional"] = *v.Secret.Optional
+			}
+			m["secret"] = []interface{}{secret}
+		}
+		sources[i] = m
+	}
+	att["sources"] = sources
+	return []interface{}{att}
+}
+
+func flattenKeyPath(in []v1.KeyToPath) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		m := map[string]interface{}{
+			"key":  v.Key,
+			"path": v.Path,
+		}
+		if v.Mode != nil {
+			m["mode"] = int(*v.Mode)
+		}
+		att[i] = m
+	}
+	return att
+}
+
 func flattenEmptyDirVolumeSource(in *v1.EmptyDirVolumeSource) []interface{} {
 	att := make(map[string]interface{})
 	att["medium"] = in.Medium
@@ -317,6 +619,10 @@ func expandPodSpec(p []interface{}) (v1.PodSpec, error) {
 		obj.ActiveDeadlineSeconds = ptrToInt64(int64(v))
 	}
 
+	if v, ok := in["affinity"].([]interface{}); ok && len(v) > 0 {
+		obj.Affinity = expandAffinity(v)
+	}
+
 	if v, ok := in["container"].([]interface{}); ok && len(v) > 0 {
 		cs, err := expandContainers(v)
 		if err != nil {
@@ -350,6 +656,14 @@ func expandPodSpec(p []interface{}) (v1.PodSpec, error) {
 		obj.ImagePullSecrets = cs
 	}
 
+	if v, ok := in["init_container"].([]interface{}); ok && len(v) > 0 {
+		cs, err := expandContainers(v)
+		if err != nil {
+			return obj, err
+		}
+		obj.InitContainers = cs
+	}
+
 	if v, ok := in["node_name"]; ok {
 		obj.NodeName = v.(string)
 	}
@@ -384,6 +698,14 @@ func expandPodSpec(p []interface{}) (v1.PodSpec, error) {
 		obj.TerminationGracePeriodSeconds = ptrToInt64(int64(v))
 	}
 
+	if v, ok := in["toleration"].([]interface{}); ok && len(v) > 0 {
+		ts, err := expandTolerations(v)
+		if err != nil {
+			return obj, err
+		}
+		obj.Tolerations = ts
+	}
+
 	if v, ok := in["volume"].([]interface{}); ok && len(v) > 0 {
 		cs, err := expandVolumes(v)
 		if err != nil {
@@ -441,6 +763,166 @@ func expandSeLinuxOptions(l []interface{}) *v1.SELinuxOptions {
 	return obj
 }
 
+func expandAffinity(l []interface{}) *v1.Affinity {
+	obj := &v1.Affinity{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	if v, ok := in["node_affinity"].([]interface{}); ok && len(v) > 0 {
+		obj.NodeAffinity = expandNodeAffinity(v)
+	}
+	if v, ok := in["pod_affinity"].([]interface{}); ok && len(v) > 0 {
+		required, preferred := expandPodAffinityTerms(v)
+		obj.PodAffinity = &v1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  required,
+			PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+		}
+	}
+	if v, ok := in["pod_anti_affinity"].([]interface{}); ok && len(v) > 0 {
+		required, preferred := expandPodAffinityTerms(v)
+		obj.PodAntiAffinity = &v1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  required,
+			PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+		}
+	}
+	return obj
+}
+
+func expandNodeAffinity(l []interface{}) *v1.NodeAffinity {
+	obj := &v1.NodeAffinity{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	if v, ok := in["required_during_scheduling_ignored_during_execution"].([]interface{}); ok && len(v) > 0 {
+		obj.RequiredDuringSchedulingIgnoredDuringExecution = &v1.NodeSelector{}
+		if v[0] != nil {
+			terms := v[0].(map[string]interface{})["node_selector_term"].([]interface{})
+			obj.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = make([]v1.NodeSelectorTerm, len(terms))
+			for i, t := range terms {
+				obj.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[i] = expandNodeSelectorTerm(t)
+			}
+		}
+	}
+	if v, ok := in["preferred_during_scheduling_ignored_during_execution"].([]interface{}); ok && len(v) > 0 {
+		obj.PreferredDuringSchedulingIgnoredDuringExecution = make([]v1.PreferredSchedulingTerm, len(v))
+		for i, t := range v {
+			m := t.(map[string]interface{})
+			term := v1.PreferredSchedulingTerm{
+				Weight: int32(m["weight"].(int)),
+			}
+			if p, ok := m["preference"].([]interface{}); ok && len(p) > 0 {
+				term.Preference = expandNodeSelectorTerm(p[0])
+			}
+			obj.PreferredDuringSchedulingIgnoredDuringExecution[i] = term
+		}
+	}
+	return obj
+}
+
+func expandNodeSelectorTerm(t interface{}) v1.NodeSelectorTerm {
+	obj := v1.NodeSelectorTerm{}
+	if t == nil {
+		return obj
+	}
+	in := t.(map[string]interface{})
+	if v, ok := in["match_expressions"].([]interface{}); ok {
+		obj.MatchExpressions = make([]v1.NodeSelectorRequirement, len(v))
+		for i, e := range v {
+			m := e.(map[string]interface{})
+			req := v1.NodeSelectorRequirement{
+				Key:      m["key"].(string),
+				Operator: v1.NodeSelectorOperator(m["operator"].(string)),
+			}
+			if vs, ok := m["values"].(*schema.Set); ok && vs.Len() > 0 {
+				req.Values = sliceOfString(vs.List())
+				sort.Strings(req.Values)
+			}
+			obj.MatchExpressions[i] = req
+		}
+	}
+	return obj
+}
+
+func expandPodAffinityTerms(l []interface{}) ([]v1.PodAffinityTerm, []v1.WeightedPodAffinityTerm) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	in := l[0].(map[string]interface{})
+	var required []v1.PodAffinityTerm
+	if v, ok := in["required_during_scheduling_ignored_during_execution"].([]interface{}); ok && len(v) > 0 {
+		required = make([]v1.PodAffinityTerm, len(v))
+		for i, t := range v {
+			required[i] = expandPodAffinityTerm(t)
+		}
+	}
+	var preferred []v1.WeightedPodAffinityTerm
+	if v, ok := in["preferred_during_scheduling_ignored_during_execution"].([]interface{}); ok && len(v) > 0 {
+		preferred = make([]v1.WeightedPodAffinityTerm, len(v))
+		for i, t := range v {
+			m := t.(map[string]interface{})
+			term := v1.WeightedPodAffinityTerm{
+				Weight: int32(m["weight"].(int)),
+			}
+			if p, ok := m["pod_affinity_term"].([]interface{}); ok && len(p) > 0 {
+				term.PodAffinityTerm = expandPodAffinityTerm(p[0])
+			}
+			preferred[i] = term
+		}
+	}
+	return required, preferred
+}
+
+func expandPodAffinityTerm(t interface{}) v1.PodAffinityTerm {
+	obj := v1.PodAffinityTerm{}
+	if t == nil {
+		return obj
+	}
+	in := t.(map[string]interface{})
+	if v, ok := in["label_selector"].([]interface{}); ok && len(v) > 0 {
+		obj.LabelSelector = expandLabelSelector(v)
+	}
+	if v, ok := in["namespaces"].(*schema.Set); ok && v.Len() > 0 {
+		obj.Namespaces = sliceOfString(v.List())
+		sort.Strings(obj.Namespaces)
+	}
+	if v, ok := in["topology_key"].(string); ok {
+		obj.TopologyKey = v
+	}
+	return obj
+}
+
+func expandTolerations(l []interface{}) ([]v1.Toleration, error) {
+	obj := make([]v1.Toleration, len(l))
+	for i, t := range l {
+		if t == nil {
+			continue
+		}
+		in := t.(map[string]interface{})
+		if v, ok := in["effect"].(string); ok {
+			obj[i].Effect = v1.TaintEffect(v)
+		}
+		if v, ok := in["key"].(string); ok {
+			obj[i].Key = v
+		}
+		if v, ok := in["operator"].(string); ok {
+			obj[i].Operator = v1.TolerationOperator(v)
+		}
+		if v, ok := in["toleration_seconds"].(string); ok && v != "" {
+			seconds, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return obj, fmt.Errorf("Invalid toleration_seconds %q: %s", v, err)
+			}
+			obj[i].TolerationSeconds = ptrToInt64(seconds)
+		}
+		if v, ok := in["value"].(string); ok {
+			obj[i].Value = v
+		}
+	}
+	return obj, nil
+}
+
 func expandKeyPath(in []interface{}) []v1.KeyToPath {
 	if len(in) == 0 {
 		return []v1.KeyToPath{}
@@ -451,7 +933,8 @@ func expandKeyPath(in []interface{}) []v1.KeyToPath {
 		if v, ok := p["key"].(string); ok {
 			keyPaths[i].Key = v
 		}
-		if v, ok := p["mode"].(int); ok {
+		// An unset mode falls back to the default mode of the volume
+		if v, ok := p["mode"].(int); ok && v != 0 {
 			keyPaths[i].Mode = ptrToInt32(int32(v))
 		}
 		if v, ok := p["path"].(string); ok {
@@ -470,7 +953,7 @@ func expandDownwardAPIVolumeFile(in []interface{}) ([]v1.DownwardAPIVolumeFile,
 	dapivf := make([]v1.DownwardAPIVolumeFile, len(in))
 	for i, c := range in {
 		p := c.(map[string]interface{})
-		if v, ok := p["mode"].(int); ok {
+		if v, ok := p["mode"].(int); ok && v != 0 {
 			dapivf[i].Mode = ptrToInt32(int32(v))
 		}
 		if v, ok := p["path"].(string); ok {
@@ -530,6 +1013,58 @@ func expandDownwardAPIVolumeSource(l []interface{}) (*v1.DownwardAPIVolumeSource
 	return obj, nil
 }
 
+func expandProjectedVolumeSource(l []interface{}) (*v1.ProjectedVolumeSource, error) {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.ProjectedVolumeSource{}, nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.ProjectedVolumeSource{
+		DefaultMode: ptrToInt32(int32(in["default_mode"].(int))),
+	}
+	sources := in["sources"].([]interface{})
+	obj.Sources = make([]v1.VolumeProjection, len(sources))
+	for i, s := range sources {
+		if s == nil {
+			continue
+		}
+		m := s.(map[string]interface{})
+		if v, ok := m["config_map"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			cm := v[0].(map[string]interface{})
+			obj.Sources[i].ConfigMap = &v1.ConfigMapProjection{
+				LocalObjectReference: v1.LocalObjectReference{Name: cm["name"].(string)},
+				Optional:             ptrToBool(cm["optional"].(bool)),
+			}
+			if items, ok := cm["items"].([]interface{}); ok && len(items) > 0 {
+				obj.Sources[i].ConfigMap.Items = expandKeyPath(items)
+			}
+		}
+		if v, ok := m["downward_api"].([]interface{}); ok && len(v) > 0 {
+			obj.Sources[i].DownwardAPI = &v1.DownwardAPIProjection{}
+			if v[0] != nil {
+				dapi := v[0].(map[string]interface{})
+				if items, ok := dapi["items"].([]interface{}); ok && len(items) > 0 {
+					var err error
+					obj.Sources[i].DownwardAPI.Items, err = expandDownwardAPIVolumeFile(items)
+					if err != nil {
+						return obj, err
+					}
+				}
+			}
+		}
+		if v, ok := m["secret"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			secret := v[0].(map[string]interface{})
+			obj.Sources[i].Secret = &v1.SecretProjection{
+				LocalObjectReference: v1.LocalObjectReference{Name: secret["name"].(string)},
+				Optional:             ptrToBool(secret["optional"].(bool)),
+			}
+			if items, ok := secret["items"].([]interface{}); ok && len(items) > 0 {
+				obj.Sources[i].Secret.Items = expandKeyPath(items)
+			}
+		}
+	}
+	return obj, nil
+}
+
 func expandGitRepoVolumeSource(l []interface{}) *v1.GitRepoVolumeSource {
 	if len(l) == 0 || l[0] == nil {
 		return &v1.GitRepoVolumeSource{}
@@ -678,35 +1213,111 @@ func expandVolumes(volumes []interface{}) ([]v1.Volume, error) {
 		if v, ok := m["photon_persistent_disk"].([]interface{}); ok && len(v) > 0 {
 			vl[i].PhotonPersistentDisk = expandPhotonPersistentDiskVolumeSource(v)
 		}
+		if v, ok := m["projected"].([]interface{}); ok && len(v) > 0 {
+			var err error
+			vl[i].Projected, err = expandProjectedVolumeSource(v)
+			if err != nil {
+				return vl, err
+			}
+		}
 	}
 	return vl, nil
 }
 
-func patchPodSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOperations, error) {
+// patchPodSpec returns the operations updating the pod spec at pathPrefix.
+// liveTolerations are those of the running pod, which may include ones the
+// API server added and which aren't kept in state.
+func patchPodSpec(pathPrefix, prefix string, d resourceChanges, liveTolerations []v1.Toleration) (PatchOperations, error) {
 	ops := make([]PatchOperation, 0)
 
 	if d.HasChange(prefix + "active_deadline_seconds") {
-
-		v := d.Get(prefix + "active_deadline_seconds").(int)
-		ops = append(ops, &ReplaceOperation{
-			Path:  pathPrefix + "/activeDeadlineSeconds",
-			Value: v,
-		})
-	}
-
-	if d.HasChange(prefix + "container") {
-		containers := d.Get(prefix + "container").([]interface{})
-		value, _ := expandContainers(containers)
-
-		for i, v := range value {
+		o, n := d.GetChange(prefix + "active_deadline_seconds")
+		err := checkActiveDeadlineSecondsChange(o.(int), n.(int))
+		if err != nil {
+			return ops, err
+		}
+		path := pathPrefix + "/activeDeadlineSeconds"
+		if o.(int) == 0 {
+			ops = append(ops, &AddOperation{
+				Path:  path,
+				Value: n.(int),
+			})
+		} else {
 			ops = append(ops, &ReplaceOperation{
-				Path:  pathPrefix + "/containers/" + strconv.Itoa(i) + "/image",
-				Value: v.Image,
+				Path:  path,
+				Value: n.(int),
 			})
+		}
+	}
 
+	if d.HasChange(prefix + "toleration") {
+		o, n := d.GetChange(prefix + "toleration")
+		oldTolerations, err := expandTolerations(o.([]interface{}))
+		if err != nil {
+			return ops, err
+		}
+		newTolerations, err := expandTolerations(n.([]interface{}))
+		if err != nil {
+			return ops, err
+		}
+		// The API only allows adding tolerations to existing pods. New ones
+		// are appended, or sent as a whole if the pod has none yet.
+		for _, t := range oldTolerations {
+			if !containsToleration(newTolerations, t) {
+				return ops, fmt.Errorf("Toleration %q (effect %q) cannot be removed or changed without recreating the pod, tolerations can only be added", t.Key, t.Effect)
+			}
 		}
+		for _, t := range liveTolerations {
+			if !containsToleration(oldTolerations, t) {
+				oldTolerations = append(oldTolerations, t)
+				newTolerations = append(newTolerations, t)
+			}
+		}
+		ops = append(ops, diffListItems(pathPrefix+"/tolerations",
+			tolerationsToList(oldTolerations), tolerationsToList(newTolerations))...)
+	}
 
+	containerLists := []struct{ key, path string }{
+		{"container", "/containers/"},
+		{"init_container", "/initContainers/"},
+	}
+	for _, l := range containerLists {
+		if !d.HasChange(prefix + l.key) {
+			continue
+		}
+		// Adding or removing containers forces a new pod, so only
+		// fields of existing containers need to be looked at here.
+		containers := d.Get(prefix + l.key).([]interface{})
+		for i := range containers {
+			key := fmt.Sprintf("%s%s.%d.image", prefix, l.key, i)
+			if d.HasChange(key) {
+				ops = append(ops, &ReplaceOperation{
+					Path:  pathPrefix + l.path + strconv.Itoa(i) + "/image",
+					Value: d.Get(key).(string),
+				})
+			}
+		}
 	}
 
 	return ops, nil
 }
+
+// checkActiveDeadlineSecondsChange returns an error unless the active
+// deadline of a running pod is set or lowered, which is all the API allows
+func checkActiveDeadlineSecondsChange(o, n int) error {
+	if n == 0 {
+		return fmt.Errorf("active_deadline_seconds cannot be removed without recreating the pod, it can only be set or lowered")
+	}
+	if o != 0 && n > o {
+		return fmt.Errorf("active_deadline_seconds cannot be raised from %d to %d without recreating the pod, it can only be set or lowered", o, n)
+	}
+	return nil
+}
+
+func tolerationsToList(tolerations []v1.Toleration) []interface{} {
+	l := make([]interface{}, len(tolerations), len(tolerations))
+	for i, t := range tolerations {
+		l[i] = t
+	}
+	return l
+}
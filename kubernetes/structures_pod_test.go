@@ -0,0 +1,964 @@
+package kubernetes
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/api/v1"
+)
+
+func TestPatchPodSpec(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Old         map[string]interface{}
+		New         map[string]interface{}
+		ExpectedOps PatchOperations
+	}{
+		{
+			Name: "container image",
+			Old: map[string]interface{}{
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.8"},
+				},
+			},
+			New: map[string]interface{}{
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+				},
+			},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path:  "/spec/containers/0/image",
+					Value: "nginx:1.7.9",
+				},
+			},
+		},
+		{
+			Name: "image of second container only",
+			Old: map[string]interface{}{
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+					map[string]interface{}{"name": "sidecar", "image": "busybox:1.26"},
+				},
+			},
+			New: map[string]interface{}{
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+					map[string]interface{}{"name": "sidecar", "image": "busybox:1.27"},
+				},
+			},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path:  "/spec/containers/1/image",
+					Value: "busybox:1.27",
+				},
+			},
+		},
+		{
+			Name: "active_deadline_seconds added",
+			Old: map[string]interface{}{
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+				},
+			},
+			New: map[string]interface{}{
+				"active_deadline_seconds": 60,
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+				},
+			},
+			ExpectedOps: []PatchOperation{
+				&AddOperation{
+					Path:  "/spec/activeDeadlineSeconds",
+					Value: 60,
+				},
+			},
+		},
+		{
+			Name: "active_deadline_seconds changed",
+			Old: map[string]interface{}{
+				"active_deadline_seconds": 60,
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+				},
+			},
+			New: map[string]interface{}{
+				"active_deadline_seconds": 30,
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+				},
+			},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path:  "/spec/activeDeadlineSeconds",
+					Value: 30,
+				},
+			},
+		},
+		{
+			Name: "image and active_deadline_seconds",
+			Old: map[string]interface{}{
+				"active_deadline_seconds": 60,
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.8"},
+				},
+			},
+			New: map[string]interface{}{
+				"active_deadline_seconds": 30,
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+				},
+			},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path:  "/spec/activeDeadlineSeconds",
+					Value: 30,
+				},
+				&ReplaceOperation{
+					Path:  "/spec/containers/0/image",
+					Value: "nginx:1.7.9",
+				},
+			},
+		},
+		{
+			Name: "init container image",
+			Old: map[string]interface{}{
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+				},
+				"init_container": []interface{}{
+					map[string]interface{}{"name": "init", "image": "busybox:1.26"},
+				},
+			},
+			New: map[string]interface{}{
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+				},
+				"init_container": []interface{}{
+					map[string]interface{}{"name": "init", "image": "busybox:1.27"},
+				},
+			},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path:  "/spec/initContainers/0/image",
+					Value: "busybox:1.27",
+				},
+			},
+		},
+		{
+			Name: "toleration added",
+			Old: map[string]interface{}{
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+				},
+				"toleration": []interface{}{
+					map[string]interface{}{"key": "dedicated", "value": "web", "effect": "NoSchedule"},
+				},
+			},
+			New: map[string]interface{}{
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+				},
+				"toleration": []interface{}{
+					map[string]interface{}{"key": "dedicated", "value": "web", "effect": "NoSchedule"},
+					map[string]interface{}{"key": "flaky", "operator": "Exists", "effect": "NoExecute", "toleration_seconds": "60"},
+				},
+			},
+			ExpectedOps: []PatchOperation{
+				&AddOperation{
+					Path: "/spec/tolerations/-",
+					Value: v1.Toleration{
+						Key:               "flaky",
+						Operator:          v1.TolerationOpExists,
+						Effect:            v1.TaintEffectNoExecute,
+						TolerationSeconds: ptrToInt64(60),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		ops, requiresNew, err := testPodSpecUpdate(t, tc.Old, tc.New)
+		if requiresNew {
+			t.Fatalf("%s: expected an in-place update, got a replacement", tc.Name)
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", tc.Name, err)
+		}
+		if !ops.Equal(tc.ExpectedOps) {
+			t.Fatalf("%s: operations don't match.\nExpected: %v\nGiven:    %v\n", tc.Name, tc.ExpectedOps, ops)
+		}
+	}
+}
+
+func TestPatchPodSpec_forceNew(t *testing.T) {
+	base := func() map[string]interface{} {
+		return map[string]interface{}{
+			"container": []interface{}{
+				map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name   string
+		Modify func(map[string]interface{})
+	}{
+		{
+			Name: "container added",
+			Modify: func(spec map[string]interface{}) {
+				spec["container"] = append(spec["container"].([]interface{}),
+					map[string]interface{}{"name": "sidecar", "image": "busybox"})
+			},
+		},
+		{
+			Name: "container removed",
+			Modify: func(spec map[string]interface{}) {
+				spec["container"] = []interface{}{}
+			},
+		},
+		{
+			Name: "container command",
+			Modify: func(spec map[string]interface{}) {
+				spec["container"].([]interface{})[0].(map[string]interface{})["command"] = []interface{}{"sleep", "3600"}
+			},
+		},
+		{
+			Name: "container env",
+			Modify: func(spec map[string]interface{}) {
+				spec["container"].([]interface{})[0].(map[string]interface{})["env"] = []interface{}{
+					map[string]interface{}{"name": "FOO", "value": "bar"},
+				}
+			},
+		},
+		{
+			Name: "init container added",
+			Modify: func(spec map[string]interface{}) {
+				spec["init_container"] = []interface{}{
+					map[string]interface{}{"name": "init", "image": "busybox"},
+				}
+			},
+		},
+		{
+			Name: "restart_policy",
+			Modify: func(spec map[string]interface{}) {
+				spec["restart_policy"] = "Never"
+			},
+		},
+		{
+			Name: "node_selector",
+			Modify: func(spec map[string]interface{}) {
+				spec["node_selector"] = map[string]interface{}{"disktype": "ssd"}
+			},
+		},
+		{
+			Name: "affinity",
+			Modify: func(spec map[string]interface{}) {
+				spec["affinity"] = []interface{}{
+					map[string]interface{}{
+						"pod_anti_affinity": []interface{}{
+							map[string]interface{}{
+								"required_during_scheduling_ignored_during_execution": []interface{}{
+									map[string]interface{}{"topology_key": "kubernetes.io/hostname"},
+								},
+							},
+						},
+					},
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		spec := base()
+		tc.Modify(spec)
+		if _, requiresNew, _ := testPodSpecUpdate(t, base(), spec); !requiresNew {
+			t.Fatalf("%s: expected change to force a new pod", tc.Name)
+		}
+	}
+}
+
+func TestPatchPodSpec_tolerationRemoved(t *testing.T) {
+	spec := func(tolerations ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"container": []interface{}{
+				map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+			},
+			"toleration": tolerations,
+		}
+	}
+	dedicated := map[string]interface{}{"key": "dedicated", "value": "web", "effect": "NoSchedule"}
+	changed := map[string]interface{}{"key": "dedicated", "value": "api", "effect": "NoSchedule"}
+
+	if _, _, err := testPodSpecUpdate(t, spec(dedicated), spec()); err == nil {
+		t.Fatal("Expected removing a toleration to fail")
+	}
+	if _, _, err := testPodSpecUpdate(t, spec(dedicated), spec(changed)); err == nil {
+		t.Fatal("Expected changing a toleration to fail")
+	}
+}
+
+func TestPatchPodSpec_activeDeadlineSecondsRaisedOrRemoved(t *testing.T) {
+	spec := func(activeDeadlineSeconds int) map[string]interface{} {
+		s := map[string]interface{}{
+			"container": []interface{}{
+				map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+			},
+		}
+		if activeDeadlineSeconds > 0 {
+			s["active_deadline_seconds"] = activeDeadlineSeconds
+		}
+		return s
+	}
+
+	if ops, _, err := testPodSpecUpdate(t, spec(60), spec(0)); err == nil {
+		t.Fatalf("Expected removing active_deadline_seconds to fail, got %v", ops)
+	}
+	if ops, _, err := testPodSpecUpdate(t, spec(60), spec(120)); err == nil {
+		t.Fatalf("Expected raising active_deadline_seconds to fail, got %v", ops)
+	}
+}
+
+func TestPatchPodSpec_tolerationAddedToPodWithout(t *testing.T) {
+	spec := func(tolerations ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"container": []interface{}{
+				map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+			},
+			"toleration": tolerations,
+		}
+	}
+	dedicated := map[string]interface{}{"key": "dedicated", "value": "web", "effect": "NoSchedule"}
+	expected := v1.Toleration{Key: "dedicated", Operator: v1.TolerationOpEqual, Value: "web", Effect: v1.TaintEffectNoSchedule}
+	notReady := v1.Toleration{
+		Key:               metav1.TaintNodeNotReady,
+		Operator:          v1.TolerationOpExists,
+		Effect:            v1.TaintEffectNoExecute,
+		TolerationSeconds: ptrToInt64(300),
+	}
+
+	// Without any tolerations on the pod, there's no list to append to
+	ops, _, err := testPodSpecUpdate(t, spec(), spec(dedicated))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedOps := PatchOperations{
+		&AddOperation{Path: "/spec/tolerations", Value: []interface{}{expected}},
+	}
+	if !ops.Equal(expectedOps) {
+		t.Fatalf("Operations don't match.\nExpected: %v\nGiven:    %v\n", expectedOps, ops)
+	}
+
+	// Default tolerations added by the API server aren't in state,
+	// but must be kept
+	ops, _, err = testPodSpecUpdate(t, spec(), spec(dedicated), notReady)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedOps = PatchOperations{
+		&AddOperation{Path: "/spec/tolerations/-", Value: expected},
+	}
+	if !ops.Equal(expectedOps) {
+		t.Fatalf("Operations don't match.\nExpected: %v\nGiven:    %v\n", expectedOps, ops)
+	}
+}
+
+// testPodSpecUpdate diffs a pod with the oldSpec in state against a
+// configuration with newSpec and returns the patch operations generated
+// by patchPodSpec during update, given the tolerations of the live pod,
+// along with its error, or whether the diff requires a new pod.
+func testPodSpecUpdate(t *testing.T, oldSpec, newSpec map[string]interface{}, liveTolerations ...v1.Toleration) (PatchOperations, bool, error) {
+	raw := func(spec map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"metadata": []interface{}{
+				map[string]interface{}{"name": "test"},
+			},
+			"spec": []interface{}{spec},
+		}
+	}
+
+	r := resourceKubernetesPod()
+	old := schema.TestResourceDataRaw(t, r.Schema, raw(oldSpec))
+	old.SetId("default/test")
+	state := old.State()
+
+	c, err := config.NewRawConfig(raw(newSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := r.Diff(state, terraform.NewResourceConfig(c))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.RequiresNew() {
+		return nil, true, nil
+	}
+
+	var ops PatchOperations
+	var updateErr error
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		ops, updateErr = patchPodSpec("/spec", "spec.0.", d, liveTolerations)
+		return nil
+	}
+	if _, err := r.Apply(state, diff, nil); err != nil {
+		t.Fatal(err)
+	}
+	return ops, false, updateErr
+}
+
+func TestFlattenPodStatus(t *testing.T) {
+	started := metav1.NewTime(time.Date(2017, 9, 1, 10, 0, 0, 0, time.UTC))
+	finished := metav1.NewTime(time.Date(2017, 9, 1, 10, 5, 0, 0, time.UTC))
+	status := v1.PodStatus{
+		Phase:     v1.PodRunning,
+		PodIP:     "10.0.0.12",
+		HostIP:    "192.168.0.3",
+		StartTime: &started,
+		QOSClass:  v1.PodQOSBurstable,
+		Conditions: []v1.PodCondition{
+			{Type: v1.PodScheduled, Status: v1.ConditionTrue},
+			{Type: v1.PodReady, Status: v1.ConditionFalse, Reason: "ContainersNotReady", Message: "containers with unready status: [sidecar]"},
+		},
+		ContainerStatuses: []v1.ContainerStatus{
+			{
+				Name:         "app",
+				Image:        "nginx:1.7.9",
+				ImageID:      "docker://sha256:1234",
+				Ready:        true,
+				RestartCount: 2,
+				State: v1.ContainerState{
+					Running: &v1.ContainerStateRunning{StartedAt: started},
+				},
+			},
+			{
+				Name:         "sidecar",
+				Image:        "busybox:1.27",
+				RestartCount: 1,
+				State: v1.ContainerState{
+					Terminated: &v1.ContainerStateTerminated{
+						ExitCode:   1,
+						Reason:     "Error",
+						StartedAt:  started,
+						FinishedAt: finished,
+					},
+				},
+			},
+			{
+				Name:  "init",
+				Image: "busybox:1.27",
+				State: v1.ContainerState{
+					Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"},
+				},
+			},
+		},
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"phase":      "Running",
+			"pod_ip":     "10.0.0.12",
+			"host_ip":    "192.168.0.3",
+			"start_time": "2017-09-01T10:00:00Z",
+			"qos_class":  "Burstable",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "PodScheduled", "status": "True", "reason": "", "message": ""},
+				map[string]interface{}{"type": "Ready", "status": "False", "reason": "ContainersNotReady", "message": "containers with unready status: [sidecar]"},
+			},
+			"container_status": []interface{}{
+				map[string]interface{}{
+					"name":          "app",
+					"image":         "nginx:1.7.9",
+					"image_id":      "docker://sha256:1234",
+					"ready":         true,
+					"restart_count": 2,
+					"state": []interface{}{map[string]interface{}{
+						"running": []interface{}{map[string]interface{}{
+							"started_at": "2017-09-01T10:00:00Z",
+						}},
+					}},
+				},
+				map[string]interface{}{
+					"name":          "sidecar",
+					"image":         "busybox:1.27",
+					"image_id":      "",
+					"ready":         false,
+					"restart_count": 1,
+					"state": []interface{}{map[string]interface{}{
+						"terminated": []interface{}{map[string]interface{}{
+							"exit_code":   1,
+							"signal":      0,
+							"reason":      "Error",
+							"message":     "",
+							"started_at":  "2017-09-01T10:00:00Z",
+							"finished_at": "2017-09-01T10:05:00Z",
+						}},
+					}},
+				},
+				map[string]interface{}{
+					"name":          "init",
+					"image":         "busybox:1.27",
+					"image_id":      "",
+					"ready":         false,
+					"restart_count": 0,
+					"state": []interface{}{map[string]interface{}{
+						"waiting": []interface{}{map[string]interface{}{
+							"reason":  "ContainerCreating",
+							"message": "",
+						}},
+					}},
+				},
+			},
+		},
+	}
+
+	flattened := flattenPodStatus(status)
+	if !reflect.DeepEqual(expected, flattened) {
+		t.Fatalf("Expected:\n%#v\nGiven:\n%#v", expected, flattened)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceKubernetesPod().Schema, map[string]interface{}{})
+	if err := d.Set("status", flattened); err != nil {
+		t.Fatalf("Failed to set flattened status: %s", err)
+	}
+	if v := d.Get("status.0.container_status.1.state.0.terminated.0.exit_code"); v != 1 {
+		t.Fatalf("Expected exit code 1 to be set, got %#v", v)
+	}
+}
+
+func TestFlattenPodStatusPending(t *testing.T) {
+	flattened := flattenPodStatus(v1.PodStatus{Phase: v1.PodPending})
+	expected := []interface{}{
+		map[string]interface{}{
+			"phase":            "Pending",
+			"pod_ip":           "",
+			"host_ip":          "",
+			"start_time":       "",
+			"qos_class":        "",
+			"conditions":       []interface{}{},
+			"container_status": []interface{}{},
+		},
+	}
+	if !reflect.DeepEqual(expected, flattened) {
+		t.Fatalf("Expected:\n%#v\nGiven:\n%#v", expected, flattened)
+	}
+}
+
+func TestExpandFlattenAffinity(t *testing.T) {
+	raw := map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{"name": "test"},
+		},
+		"spec": []interface{}{
+			map[string]interface{}{
+				"container": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+				},
+				"affinity": []interface{}{
+					map[string]interface{}{
+						"node_affinity": []interface{}{
+							map[string]interface{}{
+								"required_during_scheduling_ignored_during_execution": []interface{}{
+									map[string]interface{}{
+										"node_selector_term": []interface{}{
+											map[string]interface{}{
+												"match_expressions": []interface{}{
+													map[string]interface{}{
+														"key":      "kubernetes.io/e2e-az-name",
+														"operator": "In",
+														"values":   []interface{}{"e2e-az2", "e2e-az1"},
+													},
+													map[string]interface{}{
+														"key":      "dedicated",
+														"operator": "DoesNotExist",
+													},
+												},
+											},
+										},
+									},
+								},
+								"preferred_during_scheduling_ignored_during_execution": []interface{}{
+									map[string]interface{}{
+										"weight": 10,
+										"preference": []interface{}{
+											map[string]interface{}{
+												"match_expressions": []interface{}{
+													map[string]interface{}{
+														"key":      "disktype",
+														"operator": "In",
+														"values":   []interface{}{"ssd"},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"pod_affinity": []interface{}{
+							map[string]interface{}{
+								"required_during_scheduling_ignored_during_execution": []interface{}{
+									map[string]interface{}{
+										"label_selector": []interface{}{
+											map[string]interface{}{
+												"match_labels": map[string]interface{}{"app": "cache"},
+											},
+										},
+										"namespaces":   []interface{}{"default", "cache"},
+										"topology_key": "failure-domain.beta.kubernetes.io/zone",
+									},
+								},
+							},
+						},
+						"pod_anti_affinity": []interface{}{
+							map[string]interface{}{
+								"preferred_during_scheduling_ignored_during_execution": []interface{}{
+									map[string]interface{}{
+										"weight": 100,
+										"pod_affinity_term": []interface{}{
+											map[string]interface{}{
+												"label_selector": []interface{}{
+													map[string]interface{}{
+														"match_expressions": []interface{}{
+															map[string]interface{}{
+																"key":      "app",
+																"operator": "In",
+																"values":   []interface{}{"web"},
+															},
+														},
+													},
+												},
+												"topology_key": "kubernetes.io/hostname",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	r := resourceKubernetesPod()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	spec, err := expandPodSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &v1.Affinity{
+		NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{{
+					MatchExpressions: []v1.NodeSelectorRequirement{
+						{Key: "kubernetes.io/e2e-az-name", Operator: v1.NodeSelectorOpIn, Values: []string{"e2e-az1", "e2e-az2"}},
+						{Key: "dedicated", Operator: v1.NodeSelectorOpDoesNotExist},
+					},
+				}},
+			},
+			PreferredDuringSchedulingIgnoredDuringExecution: []v1.PreferredSchedulingTerm{{
+				Weight: 10,
+				Preference: v1.NodeSelectorTerm{
+					MatchExpressions: []v1.NodeSelectorRequirement{
+						{Key: "disktype", Operator: v1.NodeSelectorOpIn, Values: []string{"ssd"}},
+					},
+				},
+			}},
+		},
+		PodAffinity: &v1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "cache"},
+				},
+				Namespaces:  []string{"cache", "default"},
+				TopologyKey: "failure-domain.beta.kubernetes.io/zone",
+			}},
+		},
+		PodAntiAffinity: &v1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []v1.WeightedPodAffinityTerm{{
+				Weight: 100,
+				PodAffinityTerm: v1.PodAffinityTerm{
+					LabelSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"web"}},
+						},
+					},
+					TopologyKey: "kubernetes.io/hostname",
+				},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(expected, spec.Affinity) {
+		t.Fatalf("Expected:\n%#v\nGiven:\n%#v", expected, spec.Affinity)
+	}
+
+	// Reading back what was sent must not produce a diff
+	flattened, err := flattenPodSpec(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	read := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"metadata": raw["metadata"],
+	})
+	read.SetId("default/test")
+	if err := read.Set("spec", flattened); err != nil {
+		t.Fatalf("Failed to set flattened spec: %s", err)
+	}
+	c, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := r.Diff(read.State(), terraform.NewResourceConfig(c))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range diff.Attributes {
+		if strings.HasPrefix(k, "spec.") {
+			t.Fatalf("Expected no diff after round-trip, got %s: %#v", k, v)
+		}
+	}
+}
+
+// testPodSpecRoundTrip expands a pod configured with rawSpec and checks that
+// reading the expanded spec back doesn't produce a diff against it.
+func testPodSpecRoundTrip(t *testing.T, rawSpec map[string]interface{}) v1.PodSpec {
+	raw := map[string]interface{}{
+		"metadata": []interface{}{
+			map[string]interface{}{"name": "test"},
+		},
+		"spec": []interface{}{rawSpec},
+	}
+
+	r := resourceKubernetesPod()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	spec, err := expandPodSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	flattened, err := flattenPodSpec(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	read := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"metadata": raw["metadata"],
+	})
+	read.SetId("default/test")
+	if err := read.Set("spec", flattened); err != nil {
+		t.Fatalf("Failed to set flattened spec: %s", err)
+	}
+	c, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := r.Diff(read.State(), terraform.NewResourceConfig(c))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range diff.Attributes {
+		if strings.HasPrefix(k, "spec.") {
+			t.Fatalf("Expected no diff after round-trip, got %s: %#v", k, v)
+		}
+	}
+	return spec
+}
+
+func TestRemoveDefaultTolerations(t *testing.T) {
+	notReady := v1.Toleration{
+		Key:               metav1.TaintNodeNotReady,
+		Operator:          v1.TolerationOpExists,
+		Effect:            v1.TaintEffectNoExecute,
+		TolerationSeconds: ptrToInt64(300),
+	}
+	unreachable := v1.Toleration{
+		Key:               metav1.TaintNodeUnreachable,
+		Operator:          v1.TolerationOpExists,
+		Effect:            v1.TaintEffectNoExecute,
+		TolerationSeconds: ptrToInt64(300),
+	}
+	dedicated := v1.Toleration{
+		Key:      "dedicated",
+		Operator: v1.TolerationOpEqual,
+		Value:    "web",
+		Effect:   v1.TaintEffectNoSchedule,
+	}
+	longerNotReady := notReady
+	longerNotReady.TolerationSeconds = ptrToInt64(600)
+
+	testCases := []struct {
+		Live       []v1.Toleration
+		Configured []v1.Toleration
+		Expected   []v1.Toleration
+	}{
+		{
+			Live:     []v1.Toleration{notReady, unreachable},
+			Expected: []v1.Toleration{},
+		},
+		{
+			Live:       []v1.Toleration{dedicated, notReady, unreachable},
+			Configured: []v1.Toleration{dedicated},
+			Expected:   []v1.Toleration{dedicated},
+		},
+		{
+			// Configured explicitly, so the server didn't add a default
+			Live:       []v1.Toleration{longerNotReady, unreachable},
+			Configured: []v1.Toleration{longerNotReady},
+			Expected:   []v1.Toleration{longerNotReady},
+		},
+	}
+
+	for i, tc := range testCases {
+		out := removeDefaultTolerations(tc.Live, tc.Configured)
+		if !reflect.DeepEqual(tc.Expected, out) {
+			t.Fatalf("%d: Expected:\n%#v\nGiven:\n%#v", i, tc.Expected, out)
+		}
+	}
+}
+
+func TestExpandFlattenTolerations(t *testing.T) {
+	tolerations := []v1.Toleration{
+		{
+			Key:      "dedicated",
+			Operator: v1.TolerationOpEqual,
+			Value:    "web",
+			Effect:   v1.TaintEffectNoSchedule,
+		},
+		{
+			Key:               "flaky",
+			Operator:          v1.TolerationOpExists,
+			Effect:            v1.TaintEffectNoExecute,
+			TolerationSeconds: ptrToInt64(0),
+		},
+		{
+			Operator: v1.TolerationOpExists,
+		},
+	}
+
+	flattened := flattenTolerations(tolerations)
+	expected := []interface{}{
+		map[string]interface{}{
+			"key":      "dedicated",
+			"operator": "Equal",
+			"value":    "web",
+			"effect":   "NoSchedule",
+		},
+		map[string]interface{}{
+			"key":                "flaky",
+			"operator":           "Exists",
+			"effect":             "NoExecute",
+			"toleration_seconds": "0",
+		},
+		map[string]interface{}{
+			"operator": "Exists",
+		},
+	}
+	if !reflect.DeepEqual(expected, flattened) {
+		t.Fatalf("Expected:\n%#v\nGiven:\n%#v", expected, flattened)
+	}
+
+	expanded, err := expandTolerations(flattened)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tolerations, expanded) {
+		t.Fatalf("Expected:\n%#v\nGiven:\n%#v", tolerations, expanded)
+	}
+}
+
+func TestExpandFlattenProjectedVolume(t *testing.T) {
+	spec := testPodSpecRoundTrip(t, map[string]interface{}{
+		"container": []interface{}{
+			map[string]interface{}{"name": "app", "image": "nginx:1.7.9"},
+		},
+		"volume": []interface{}{
+			map[string]interface{}{
+				"name": "all-in-one",
+				"projected": []interface{}{
+					map[string]interface{}{
+						"default_mode": 0440,
+						"sources": []interface{}{
+							map[string]interface{}{
+								"secret": []interface{}{
+									map[string]interface{}{
+										"name": "credentials",
+										"items": []interface{}{
+											map[string]interface{}{"key": "username", "path": "my-group/my-username"},
+											map[string]interface{}{"key": "password", "path": "my-group/my-password", "mode": 0400},
+										},
+									},
+								},
+							},
+							map[string]interface{}{
+								"downward_api": []interface{}{
+									map[string]interface{}{
+										"items": []interface{}{
+											map[string]interface{}{
+												"path": "labels",
+												"field_ref": []interface{}{
+													map[string]interface{}{"field_path": "metadata.labels"},
+												},
+											},
+										},
+									},
+								},
+							},
+							map[string]interface{}{
+								"config_map": []interface{}{
+									map[string]interface{}{
+										"name":     "settings",
+										"optional": true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	expected := &v1.ProjectedVolumeSource{
+		DefaultMode: ptrToInt32(0440),
+		Sources: []v1.VolumeProjection{
+			{
+				Secret: &v1.SecretProjection{
+					LocalObjectReference: v1.LocalObjectReference{Name: "credentials"},
+					Items: []v1.KeyToPath{
+						{Key: "username", Path: "my-group/my-username"},
+						{Key: "password", Path: "my-group/my-password", Mode: ptrToInt32(0400)},
+					},
+					Optional: ptrToBool(false),
+				},
+			},
+			{
+				DownwardAPI: &v1.DownwardAPIProjection{
+					Items: []v1.DownwardAPIVolumeFile{
+						{
+							Path:     "labels",
+							FieldRef: &v1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.labels"},
+						},
+					},
+				},
+			},
+			{
+				ConfigMap: &v1.ConfigMapProjection{
+					LocalObjectReference: v1.LocalObjectReference{Name: "settings"},
+					Optional:             ptrToBool(true),
+				},
+			},
+		},
+	}
+	if len(spec.Volumes) != 1 {
+		t.Fatalf("Expected 1 volume, got %d", len(spec.Volumes))
+	}
+	if !reflect.DeepEqual(expected, spec.Volumes[0].Projected) {
+		t.Fatalf("Expected:\n%#v\nGiven:\n%#v", expected, spec.Volumes[0].Projected)
+	}
+}
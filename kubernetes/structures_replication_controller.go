@@ -13,7 +13,14 @@ func flattenReplicationControllerSpec(in v1.ReplicationControllerSpec) ([]interf
 		att["replicas"] = *in.Replicas
 	}
 
-	att["selector"] = in.Selector
+	// The hash of the template added by rolling updates isn't configured
+	selector := make(map[string]string, len(in.Selector))
+	for k, v := range in.Selector {
+		if k != rollingUpdateHashKey {
+			selector[k] = v
+		}
+	}
+	att["selector"] = selector
 	podSpec, err := flattenPodSpec(in.Template.Spec)
 	if err != nil {
 		return nil, err
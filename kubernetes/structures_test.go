@@ -1,8 +1,19 @@
 package kubernetes
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	api "k8s.io/kubernetes/pkg/api/v1"
 )
 
 func TestIsInternalKey(t *testing.T) {
@@ -30,3 +41,589 @@ func TestIsInternalKey(t *testing.T) {
 		})
 	}
 }
+
+func TestImportNamespacedState(t *testing.T) {
+	testCases := []struct {
+		ID         string
+		ExpectedID string
+	}{
+		{"my-config", "team-a/my-config"},
+		{"other/my-config", "other/my-config"},
+		{"default/my-config", "default/my-config"},
+	}
+	meta := &kubeProvider{namespace: "team-a"}
+
+	for _, tc := range testCases {
+		d := resourceKubernetesConfigMap().Data(nil)
+		d.SetId(tc.ID)
+		out, err := importNamespacedState(d, meta)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.ID, err)
+		}
+		if len(out) != 1 || out[0].Id() != tc.ExpectedID {
+			t.Fatalf("%s: expected ID %q, got %q", tc.ID, tc.ExpectedID, out[0].Id())
+		}
+	}
+}
+
+func TestExpandFlattenMetadata(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"annotations": map[string]interface{}{},
+			"finalizers":  []interface{}{"example.com/cleanup"},
+			"labels":      map[string]interface{}{"app": "test"},
+			"name":        "test",
+			"namespace":   "default",
+			"owner_reference": []interface{}{
+				map[string]interface{}{
+					"api_version":          "v1",
+					"block_owner_deletion": true,
+					"controller":           true,
+					"kind":                 "ConfigMap",
+					"name":                 "parent",
+					"uid":                  "6d0fcc1e-9d7b-11e7-a73a-080027f3c3d4",
+				},
+				map[string]interface{}{
+					"api_version": "v1",
+					"kind":        "Secret",
+					"name":        "other-parent",
+					"uid":         "7a3ed0b2-9d7b-11e7-a73a-080027f3c3d4",
+				},
+			},
+		},
+	}
+	expected := metav1.ObjectMeta{
+		Annotations: map[string]string{},
+		Finalizers:  []string{"example.com/cleanup"},
+		Labels:      map[string]string{"app": "test"},
+		Name:        "test",
+		Namespace:   "default",
+		OwnerReferences: []metav1.OwnerReference{
+			{
+				APIVersion:         "v1",
+				BlockOwnerDeletion: ptrToBool(true),
+				Controller:         ptrToBool(true),
+				Kind:               "ConfigMap",
+				Name:               "parent",
+				UID:                "6d0fcc1e-9d7b-11e7-a73a-080027f3c3d4",
+			},
+			{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Name:       "other-parent",
+				UID:        "7a3ed0b2-9d7b-11e7-a73a-080027f3c3d4",
+			},
+		},
+	}
+
+	meta := expandMetadata(in)
+	if !reflect.DeepEqual(meta, expected) {
+		t.Fatalf("Unexpected metadata.\nExpected: %#v\nGiven:    %#v", expected, meta)
+	}
+
+	// Finalizers and owner references added by the cluster aren't kept
+	d := resourceKubernetesConfigMap().Data(nil)
+	if err := d.Set("metadata", in); err != nil {
+		t.Fatal(err)
+	}
+	meta.Finalizers = append([]string{"kubernetes.io/pvc-protection"}, meta.Finalizers...)
+	meta.OwnerReferences = append(meta.OwnerReferences, metav1.OwnerReference{
+		APIVersion: "apps/v1beta1",
+		Kind:       "StatefulSet",
+		Name:       "web",
+		UID:        "8b2f1c3a-9d7b-11e7-a73a-080027f3c3d4",
+	})
+	out := flattenMetadata(meta, d)[0]
+	if !reflect.DeepEqual(out["finalizers"], []string{"example.com/cleanup"}) {
+		t.Fatalf("Unexpected finalizers: %#v", out["finalizers"])
+	}
+	expectedRefs := in[0].(map[string]interface{})["owner_reference"]
+	if !reflect.DeepEqual(out["owner_reference"], expectedRefs) {
+		t.Fatalf("Unexpected owner references.\nExpected: %#v\nGiven:    %#v", expectedRefs, out["owner_reference"])
+	}
+}
+
+func TestPatchMetadataLists(t *testing.T) {
+	ref := func(name, uid string) map[string]interface{} {
+		return map[string]interface{}{
+			"api_version": "v1",
+			"kind":        "ConfigMap",
+			"name":        name,
+			"uid":         uid,
+		}
+	}
+
+	liveRef := func(name, uid string) metav1.OwnerReference {
+		return metav1.OwnerReference{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Name:       name,
+			UID:        types.UID(uid),
+			Controller: ptrToBool(false),
+		}
+	}
+
+	testCases := []struct {
+		Name        string
+		Old         map[string]interface{}
+		New         map[string]interface{}
+		Live        metav1.ObjectMeta
+		ExpectedOps PatchOperations
+	}{
+		{
+			Name: "finalizer added",
+			Old:  map[string]interface{}{"name": "test"},
+			New: map[string]interface{}{
+				"name":       "test",
+				"finalizers": []interface{}{"example.com/cleanup"},
+			},
+			ExpectedOps: []PatchOperation{
+				&AddOperation{
+					Path:  "/metadata/finalizers",
+					Value: []interface{}{"example.com/cleanup"},
+				},
+			},
+		},
+		{
+			Name: "finalizer replaced",
+			Old: map[string]interface{}{
+				"name":       "test",
+				"finalizers": []interface{}{"example.com/cleanup"},
+			},
+			New: map[string]interface{}{
+				"name":       "test",
+				"finalizers": []interface{}{"example.com/other"},
+			},
+			Live: metav1.ObjectMeta{
+				Finalizers: []string{"example.com/cleanup"},
+			},
+			ExpectedOps: []PatchOperation{
+				&RemoveOperation{
+					Path: "/metadata/finalizers/0",
+				},
+				&AddOperation{
+					Path:  "/metadata/finalizers/-",
+					Value: "example.com/other",
+				},
+			},
+		},
+		{
+			Name: "finalizer removed",
+			Old: map[string]interface{}{
+				"name":       "test",
+				"finalizers": []interface{}{"example.com/a", "example.com/b", "example.com/c"},
+			},
+			New: map[string]interface{}{
+				"name":       "test",
+				"finalizers": []interface{}{"example.com/a", "example.com/c"},
+			},
+			Live: metav1.ObjectMeta{
+				Finalizers: []string{"example.com/a", "kubernetes.io/pvc-protection", "example.com/b", "example.com/c"},
+			},
+			ExpectedOps: []PatchOperation{
+				&RemoveOperation{
+					Path: "/metadata/finalizers/2",
+				},
+			},
+		},
+		{
+			Name: "all finalizers removed",
+			Old: map[string]interface{}{
+				"name":       "test",
+				"finalizers": []interface{}{"example.com/cleanup"},
+			},
+			New: map[string]interface{}{
+				"name":       "test",
+				"finalizers": []interface{}{},
+			},
+			Live: metav1.ObjectMeta{
+				Finalizers: []string{"foregroundDeletion", "example.com/cleanup"},
+			},
+			ExpectedOps: []PatchOperation{
+				&RemoveOperation{
+					Path: "/metadata/finalizers/1",
+				},
+			},
+		},
+		{
+			Name: "owner reference replaced",
+			Old: map[string]interface{}{
+				"name":            "test",
+				"owner_reference": []interface{}{ref("parent", "6d0fcc1e-9d7b-11e7-a73a-080027f3c3d4")},
+			},
+			New: map[string]interface{}{
+				"name":            "test",
+				"owner_reference": []interface{}{ref("new-parent", "7a3ed0b2-9d7b-11e7-a73a-080027f3c3d4")},
+			},
+			Live: metav1.ObjectMeta{
+				OwnerReferences: []metav1.OwnerReference{liveRef("parent", "6d0fcc1e-9d7b-11e7-a73a-080027f3c3d4")},
+			},
+			ExpectedOps: []PatchOperation{
+				&RemoveOperation{
+					Path: "/metadata/ownerReferences/0",
+				},
+				&AddOperation{
+					Path: "/metadata/ownerReferences/-",
+					Value: metav1.OwnerReference{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+						Name:       "new-parent",
+						UID:        "7a3ed0b2-9d7b-11e7-a73a-080027f3c3d4",
+					},
+				},
+			},
+		},
+		{
+			Name: "owner reference removed",
+			Old: map[string]interface{}{
+				"name":            "test",
+				"owner_reference": []interface{}{ref("parent", "6d0fcc1e-9d7b-11e7-a73a-080027f3c3d4")},
+			},
+			New: map[string]interface{}{"name": "test"},
+			Live: metav1.ObjectMeta{
+				OwnerReferences: []metav1.OwnerReference{
+					liveRef("controller", "8b2f1c3a-9d7b-11e7-a73a-080027f3c3d4"),
+					liveRef("parent", "6d0fcc1e-9d7b-11e7-a73a-080027f3c3d4"),
+				},
+			},
+			ExpectedOps: []PatchOperation{
+				&RemoveOperation{
+					Path: "/metadata/ownerReferences/1",
+				},
+			},
+		},
+		{
+			Name: "finalizer added next to one set by the cluster",
+			Old:  map[string]interface{}{"name": "test"},
+			New: map[string]interface{}{
+				"name":       "test",
+				"finalizers": []interface{}{"example.com/cleanup"},
+			},
+			Live: metav1.ObjectMeta{
+				Finalizers: []string{"kubernetes.io/pvc-protection"},
+			},
+			ExpectedOps: []PatchOperation{
+				&AddOperation{
+					Path:  "/metadata/finalizers/-",
+					Value: "example.com/cleanup",
+				},
+			},
+		},
+		{
+			Name: "owner reference changed",
+			Old: map[string]interface{}{
+				"name":            "test",
+				"owner_reference": []interface{}{ref("parent", "6d0fcc1e-9d7b-11e7-a73a-080027f3c3d4")},
+			},
+			New: map[string]interface{}{
+				"name": "test",
+				"owner_reference": []interface{}{map[string]interface{}{
+					"api_version": "v1",
+					"controller":  true,
+					"kind":        "ConfigMap",
+					"name":        "parent",
+					"uid":         "6d0fcc1e-9d7b-11e7-a73a-080027f3c3d4",
+				}},
+			},
+			Live: metav1.ObjectMeta{
+				OwnerReferences: []metav1.OwnerReference{
+					liveRef("controller", "8b2f1c3a-9d7b-11e7-a73a-080027f3c3d4"),
+					liveRef("parent", "6d0fcc1e-9d7b-11e7-a73a-080027f3c3d4"),
+				},
+			},
+			ExpectedOps: []PatchOperation{
+				&ReplaceOperation{
+					Path: "/metadata/ownerReferences/1",
+					Value: metav1.OwnerReference{
+						APIVersion: "v1",
+						Controller: ptrToBool(true),
+						Kind:       "ConfigMap",
+						Name:       "parent",
+						UID:        "6d0fcc1e-9d7b-11e7-a73a-080027f3c3d4",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		r := resourceKubernetesConfigMap()
+		old := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"metadata": []interface{}{tc.Old},
+		})
+		old.SetId("default/test")
+		state := old.State()
+
+		c, err := config.NewRawConfig(map[string]interface{}{
+			"metadata": []interface{}{tc.New},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		diff, err := r.Diff(state, terraform.NewResourceConfig(c))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var ops PatchOperations
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			ops = patchMetadataLists("/metadata/", d, tc.Live)
+			return nil
+		}
+		if _, err := r.Apply(state, diff, nil); err != nil {
+			t.Fatal(err)
+		}
+		if !ops.Equal(tc.ExpectedOps) {
+			t.Fatalf("%s: operations don't match.\nExpected: %v\nGiven:    %v\n", tc.Name, tc.ExpectedOps, ops)
+		}
+	}
+}
+
+func TestPatchResource(t *testing.T) {
+	conflict := &errors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    422,
+		Reason:  metav1.StatusReasonInvalid,
+		Message: "testing value /metadata/resourceVersion failed: 42",
+	}}
+	invalid := &errors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    422,
+		Reason:  metav1.StatusReasonInvalid,
+		Message: "metadata.labels: Invalid value",
+	}}
+	other := fmt.Errorf("connection refused")
+	labelOps := PatchOperations{
+		&ReplaceOperation{Path: "/metadata/labels/foo", Value: "bar"},
+	}
+	testCases := []struct {
+		Name             string
+		RetryOnConflict  bool
+		Ops              PatchOperations
+		Errors           []error
+		ExpectedVersions []string
+		ExpectedErr      string
+	}{
+		{
+			Name:             "success",
+			RetryOnConflict:  true,
+			Ops:              labelOps,
+			Errors:           []error{nil},
+			ExpectedVersions: []string{"1"},
+		},
+		{
+			Name:             "retried conflict",
+			RetryOnConflict:  true,
+			Ops:              labelOps,
+			Errors:           []error{conflict, nil},
+			ExpectedVersions: []string{"1", "2"},
+		},
+		{
+			Name:             "conflict without retry",
+			RetryOnConflict:  false,
+			Ops:              labelOps,
+			Errors:           []error{conflict},
+			ExpectedVersions: []string{"1"},
+			ExpectedErr:      "object changed since last refresh",
+		},
+		{
+			Name:             "persistent conflict",
+			RetryOnConflict:  true,
+			Ops:              labelOps,
+			Errors:           []error{conflict, conflict, conflict, conflict, conflict},
+			ExpectedVersions: []string{"1", "2", "3", "4", "5"},
+			ExpectedErr:      "object changed since last refresh",
+		},
+		{
+			Name:             "other error",
+			RetryOnConflict:  true,
+			Ops:              labelOps,
+			Errors:           []error{other},
+			ExpectedVersions: []string{"1"},
+			ExpectedErr:      "connection refused",
+		},
+		{
+			Name:             "invalid patch",
+			RetryOnConflict:  true,
+			Ops:              labelOps,
+			Errors:           []error{invalid},
+			ExpectedVersions: []string{"1"},
+			ExpectedErr:      "metadata.labels: Invalid value",
+		},
+	}
+	backoff := conflictRetryBackoff
+	conflictRetryBackoff = 0
+	defer func() { conflictRetryBackoff = backoff }()
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				"metadata": namespacedMetadataSchema("test", false),
+			}, map[string]interface{}{})
+			d.SetId("default/test")
+			setVersion := func(v int) {
+				d.Set("metadata", []interface{}{map[string]interface{}{
+					"name":             "test",
+					"resource_version": fmt.Sprintf("%d", v),
+				}})
+			}
+			setVersion(1)
+
+			reads := 1
+			read := func(d *schema.ResourceData, meta interface{}) error {
+				reads++
+				setVersion(reads)
+				return nil
+			}
+			versions := make([]string, 0)
+			patch := func(data []byte) error {
+				var sent []map[string]interface{}
+				if err := json.Unmarshal(data, &sent); err != nil {
+					t.Fatal(err)
+				}
+				if len(sent) != 2 || sent[0]["op"] != "test" || sent[0]["path"] != "/metadata/resourceVersion" {
+					t.Fatalf("Expected resource version test to be prepended, got %s", data)
+				}
+				versions = append(versions, sent[0]["value"].(string))
+				return tc.Errors[len(versions)-1]
+			}
+
+			meta := &kubeProvider{retryOnConflict: tc.RetryOnConflict}
+			buildOps := func(d resourceChanges) (PatchOperations, error) {
+				return tc.Ops, nil
+			}
+			err := patchResource(d, meta, buildOps, read, patch)
+			if tc.ExpectedErr == "" && err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if tc.ExpectedErr != "" && (err == nil || !strings.Contains(err.Error(), tc.ExpectedErr)) {
+				t.Fatalf("Expected error containing %q, got: %v", tc.ExpectedErr, err)
+			}
+			if !reflect.DeepEqual(versions, tc.ExpectedVersions) {
+				t.Fatalf("Expected patches against versions %q, got %q", tc.ExpectedVersions, versions)
+			}
+		})
+	}
+}
+
+func TestPatchResourceRebuildsOps(t *testing.T) {
+	conflict := &errors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    500,
+		Reason:  metav1.StatusReasonInternalError,
+		Message: "Internal error occurred: Testing value /metadata/resourceVersion failed",
+	}}
+	backoff := conflictRetryBackoff
+	conflictRetryBackoff = 0
+	defer func() { conflictRetryBackoff = backoff }()
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"metadata": namespacedMetadataSchema("test", false),
+	}, map[string]interface{}{
+		"metadata": []interface{}{map[string]interface{}{
+			"name":   "test",
+			"labels": map[string]interface{}{"app": "web"},
+		}},
+	})
+	d.SetId("default/test")
+
+	read := func(d *schema.ResourceData, meta interface{}) error {
+		return d.Set("metadata", []interface{}{map[string]interface{}{
+			"name":             "test",
+			"annotations":      map[string]interface{}{"note": "changed by others"},
+			"labels":           map[string]interface{}{"app": "db", "tier": "backend"},
+			"resource_version": "2",
+		}})
+	}
+	var built []PatchOperations
+	buildOps := func(d resourceChanges) (PatchOperations, error) {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		built = append(built, ops)
+		return ops, nil
+	}
+	errs := []error{conflict, nil}
+	patch := func(data []byte) error {
+		err := errs[0]
+		errs = errs[1:]
+		return err
+	}
+
+	err := patchResource(d, &kubeProvider{retryOnConflict: true}, buildOps, read, patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		`[{"path":"/metadata/labels/app","value":"web","op":"add"}]`,
+		// Only the planned change of labels is rebuilt against the object
+		// as re-read, the annotations changed by others are left alone
+		`[{"path":"/metadata/labels/tier","op":"remove"},` +
+			`{"path":"/metadata/labels/app","value":"web","op":"replace"}]`,
+	}
+	if len(built) != len(expected) {
+		t.Fatalf("Expected operations to be built %d times, got %d", len(expected), len(built))
+	}
+	for i, ops := range built {
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != expected[i] {
+			t.Fatalf("Attempt %d: operations don't match.\nExpected: %s\nGiven:    %s\n", i+1, expected[i], data)
+		}
+	}
+}
+
+func TestIsResourceVersionConflict(t *testing.T) {
+	status := func(reason metav1.StatusReason, message string) error {
+		return &errors.StatusError{ErrStatus: metav1.Status{Reason: reason, Message: message}}
+	}
+	testCases := []struct {
+		Err      error
+		Expected bool
+	}{
+		{fmt.Errorf("testing value /metadata/resourceVersion failed"), false},
+		{status(metav1.StatusReasonConflict, "the object has been modified"), true},
+		{status(metav1.StatusReasonInvalid, "testing value /metadata/resourceVersion failed: test failed"), true},
+		{status(metav1.StatusReasonInternalError, "Internal error occurred: Testing value /metadata/resourceVersion failed"), true},
+		{status(metav1.StatusReasonInvalid, "testing value /spec/replicas failed: test failed"), false},
+		{status(metav1.StatusReasonInvalid, "spec.template.spec.containers[0].image: Required value"), false},
+		{status(metav1.StatusReasonInternalError, "Internal error occurred: replace operation does not apply"), false},
+		{status(metav1.StatusReasonNotFound, ""), false},
+		{status(metav1.StatusReasonForbidden, ""), false},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			if isResourceVersionConflict(tc.Err) != tc.Expected {
+				t.Fatalf("Expected conflict to be %t for %#v", tc.Expected, tc.Err)
+			}
+		})
+	}
+}
+
+func TestStrategicPatchResource(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"metadata": namespacedMetadataSchema("test", false),
+	}, map[string]interface{}{})
+	d.SetId("default/test")
+	d.Set("metadata", []interface{}{map[string]interface{}{
+		"name":             "test",
+		"resource_version": "7",
+	}})
+
+	oldObj := &api.ConfigMap{Data: map[string]string{"one": "1"}}
+	newObj := &api.ConfigMap{Data: map[string]string{"two": "2"}}
+	var sent map[string]interface{}
+	err := strategicPatchResource(d, &kubeProvider{}, oldObj, newObj, nil, func(data []byte) error {
+		return json.Unmarshal(data, &sent)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"metadata": map[string]interface{}{"resourceVersion": "7"},
+		"data":     map[string]interface{}{"one": nil, "two": "2"},
+	}
+	if !reflect.DeepEqual(expected, sent) {
+		t.Fatalf("Expected patch %#v, got %#v", expected, sent)
+	}
+}
@@ -2,8 +2,10 @@ package kubernetes
 
 import (
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -103,6 +105,14 @@ func validatePortNumOrName(value interface{}, key string) (ws []string, es []err
 	}
 }
 
+func validateClusterIP(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if v != "" && v != "None" && net.ParseIP(v) == nil {
+		es = append(es, fmt.Errorf("%s must be a valid IP address or \"None\", got %q", key, v))
+	}
+	return
+}
+
 func validateResourceList(value interface{}, key string) (ws []string, es []error) {
 	m := value.(map[string]interface{})
 	for k, value := range m {
@@ -142,6 +152,68 @@ func validatePositiveInteger(value interface{}, key string) (ws []string, es []e
 	return
 }
 
+func validateNonNegativeInteger(value interface{}, key string) (ws []string, es []error) {
+	v := value.(int)
+	if v < 0 {
+		es = append(es, fmt.Errorf("%s must be greater than or equal to 0", key))
+	}
+	return
+}
+
+func validateAffinityWeight(value interface{}, key string) (ws []string, es []error) {
+	v := value.(int)
+	if v < 1 || v > 100 {
+		es = append(es, fmt.Errorf("%s must be in the range 1-100, got %d", key, v))
+	}
+	return
+}
+
+func validateIntegerString(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+		es = append(es, fmt.Errorf("%s must be an integer, got %q", key, v))
+	}
+	return
+}
+
+func validateIntOrPercent(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	i, err := strconv.Atoi(strings.TrimSuffix(v, "%"))
+	if err != nil || i < 0 {
+		es = append(es, fmt.Errorf("%s must be a non-negative integer or a percentage (e.g. 10%%), got %q", key, v))
+		return
+	}
+	if strings.HasSuffix(v, "%") && i > 100 {
+		es = append(es, fmt.Errorf("%s must not be greater than 100%%, got %q", key, v))
+	}
+	return
+}
+
+func validatePositiveIntOrPercent(value interface{}, key string) (ws []string, es []error) {
+	ws, es = validateIntOrPercent(value, key)
+	if len(es) > 0 {
+		return
+	}
+	v := value.(string)
+	if i, _ := strconv.Atoi(strings.TrimSuffix(v, "%")); i == 0 {
+		es = append(es, fmt.Errorf("%s must be greater than 0, got %q", key, v))
+	}
+	return
+}
+
+func validateDuration(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("%s must be a duration (e.g. 1m30s): %s", key, err))
+		return
+	}
+	if d < 0 {
+		es = append(es, fmt.Errorf("%s must not be negative, got %q", key, v))
+	}
+	return
+}
+
 func validateDNSPolicy(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
 	if v != "ClusterFirst" && v != "Default" {
@@ -208,3 +280,117 @@ func validateAttributeValueIsIn(validValues []string) schema.SchemaValidateFunc
 
 	}
 }
+
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+	// Whether "?" may be used in place of "*"
+	anyValue bool
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31, anyValue: true},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}},
+	{name: "day of week", min: 0, max: 6, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}, anyValue: true},
+}
+
+var cronDescriptors = []string{
+	"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly",
+}
+
+// validateCronExpression checks the schedule format accepted by
+// the CronJob controller: five space separated fields or one of
+// the predefined @ descriptors.
+func validateCronExpression(value interface{}, key string) (ws []string, es []error) {
+	v := strings.TrimSpace(value.(string))
+
+	if strings.HasPrefix(v, "@") {
+		if strings.HasPrefix(v, "@every ") {
+			d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(v, "@every ")))
+			if err != nil {
+				es = append(es, fmt.Errorf("%s (%q): %s", key, v, err))
+			} else if d <= 0 {
+				es = append(es, fmt.Errorf("%s (%q): duration must be positive", key, v))
+			}
+			return
+		}
+		for _, desc := range cronDescriptors {
+			if v == desc {
+				return
+			}
+		}
+		es = append(es, fmt.Errorf("%s (%q): unrecognized descriptor, must be one of %s or @every <duration>",
+			key, v, strings.Join(cronDescriptors, ", ")))
+		return
+	}
+
+	parts := strings.Fields(v)
+	if len(parts) != len(cronFields) {
+		es = append(es, fmt.Errorf("%s (%q): expected exactly %d fields, found %d",
+			key, v, len(cronFields), len(parts)))
+		return
+	}
+
+	for i, part := range parts {
+		if err := validateCronField(part, cronFields[i]); err != nil {
+			es = append(es, fmt.Errorf("%s (%q): %s", key, v, err))
+		}
+	}
+	return
+}
+
+func validateCronField(value string, f cronField) error {
+	for _, expr := range strings.Split(value, ",") {
+		rangeExpr := expr
+		if i := strings.Index(expr, "/"); i >= 0 {
+			rangeExpr = expr[:i]
+			step, err := strconv.Atoi(expr[i+1:])
+			if err != nil || step <= 0 {
+				return fmt.Errorf("invalid step %q in %s field", expr[i+1:], f.name)
+			}
+		}
+
+		if rangeExpr == "*" || (rangeExpr == "?" && f.anyValue) {
+			continue
+		}
+
+		bounds := strings.Split(rangeExpr, "-")
+		if len(bounds) > 2 {
+			return fmt.Errorf("invalid range %q in %s field", rangeExpr, f.name)
+		}
+		values := make([]int, len(bounds))
+		for j, b := range bounds {
+			n, err := parseCronValue(b, f)
+			if err != nil {
+				return err
+			}
+			values[j] = n
+		}
+		if len(values) == 2 && values[0] > values[1] {
+			return fmt.Errorf("beginning of range %q is greater than its end in %s field", rangeExpr, f.name)
+		}
+	}
+	return nil
+}
+
+func parseCronValue(value string, f cronField) (int, error) {
+	if n, ok := f.names[strings.ToLower(value)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", value, f.name)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %d out of range (%d-%d) in %s field", n, f.min, f.max, f.name)
+	}
+	return n, nil
+}
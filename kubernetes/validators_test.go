@@ -25,3 +25,176 @@ func TestValidateModeBits(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateClusterIP(t *testing.T) {
+	validCases := []string{
+		"", "None", "10.0.0.10", "fd00::10",
+	}
+	for _, v := range validCases {
+		_, es := validateClusterIP(v, "cluster_ip")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", v, es)
+		}
+	}
+
+	invalidCases := []string{
+		"none", "10.0.0", "10.0.0.256", "headless",
+	}
+	for _, v := range invalidCases {
+		_, es := validateClusterIP(v, "cluster_ip")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestValidateAffinityWeight(t *testing.T) {
+	validCases := []int{
+		1, 50, 100,
+	}
+	for _, w := range validCases {
+		_, es := validateAffinityWeight(w, "weight")
+		if len(es) > 0 {
+			t.Fatalf("Expected %d to be valid: %#v", w, es)
+		}
+	}
+
+	invalidCases := []int{
+		-1, 0, 101,
+	}
+	for _, w := range invalidCases {
+		_, es := validateAffinityWeight(w, "weight")
+		if len(es) == 0 {
+			t.Fatalf("Expected %d to be invalid", w)
+		}
+	}
+}
+
+func TestValidateIntegerString(t *testing.T) {
+	validCases := []string{
+		"0", "300", "-1",
+	}
+	for _, v := range validCases {
+		_, es := validateIntegerString(v, "toleration_seconds")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", v, es)
+		}
+	}
+
+	invalidCases := []string{
+		"", "5m", "1.5", "ten",
+	}
+	for _, v := range invalidCases {
+		_, es := validateIntegerString(v, "toleration_seconds")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestValidateIntOrPercent(t *testing.T) {
+	validCases := []string{
+		"0", "1", "25", "0%", "50%", "100%",
+	}
+	for _, v := range validCases {
+		_, es := validateIntOrPercent(v, "min_available")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", v, es)
+		}
+	}
+
+	invalidCases := []string{
+		"", "-1", "%", "-5%", "101%", "1.5", "50 %", "five",
+	}
+	for _, v := range invalidCases {
+		_, es := validateIntOrPercent(v, "min_available")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestValidatePositiveIntOrPercent(t *testing.T) {
+	validCases := []string{
+		"1", "25", "1%", "100%",
+	}
+	for _, v := range validCases {
+		_, es := validatePositiveIntOrPercent(v, "max_unavailable")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", v, es)
+		}
+	}
+
+	invalidCases := []string{
+		"", "0", "0%", "-1", "101%", "five",
+	}
+	for _, v := range invalidCases {
+		_, es := validatePositiveIntOrPercent(v, "max_unavailable")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	validCases := []string{
+		"0", "0s", "30s", "1m", "1h30m", "500ms",
+	}
+	for _, v := range validCases {
+		_, es := validateDuration(v, "update_period")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", v, es)
+		}
+	}
+
+	invalidCases := []string{
+		"", "1", "-1m", "1 minute", "m",
+	}
+	for _, v := range invalidCases {
+		_, es := validateDuration(v, "update_period")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestValidateCronExpression(t *testing.T) {
+	validCases := []string{
+		"*/1 * * * *",
+		"0 0 * * *",
+		"15,45 9-17 * * MON-FRI",
+		"0 3 1 jan,jul ?",
+		"0-30/10 */2 1-15 * 0",
+		"@hourly",
+		"@every 1h30m",
+	}
+	for _, expr := range validCases {
+		_, es := validateCronExpression(expr, "schedule")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", expr, es)
+		}
+	}
+
+	invalidCases := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 7",
+		"30-10 * * * *",
+		"*/0 * * * *",
+		"? * * * *",
+		"* * * foo *",
+		"@fortnightly",
+		"@every forever",
+	}
+	for _, expr := range invalidCases {
+		_, es := validateCronExpression(expr, "schedule")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", expr)
+		}
+	}
+}